@@ -0,0 +1,221 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const directive = "//gonads:sum"
+
+// sumType describes a sum type to generate.
+type sumType struct {
+	Name     string
+	Variants []variant
+}
+
+// variant describes a single case of a sum type.
+// Unit variants carry no value, and have an empty Type.
+type variant struct {
+	Name string
+	Type string
+}
+
+// pkgModel holds every sum type declared in a package, along with the imports their variants need.
+type pkgModel struct {
+	Package string
+	Imports map[string]string // path -> name
+	Sums    []sumType
+}
+
+// Generate parses and type-checks the package in dir, and returns the formatted source of the sum types
+// declared in it. The file named out is excluded from parsing, so that stale output from a previous run
+// cannot conflict with the types being generated.
+func Generate(dir, out string) ([]byte, error) {
+	model, err := load(dir, out)
+	if err != nil {
+		return nil, err
+	}
+	if len(model.Sums) == 0 {
+		return nil, fmt.Errorf("no %s declarations found in %s", directive, dir)
+	}
+	return render(model)
+}
+
+func load(dir, out string) (*pkgModel, error) {
+	bpkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for _, name := range bpkg.GoFiles {
+		if name == out {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{Types: make(map[ast.Expr]types.TypeAndValue)}
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		// The rest of the package may refer to the types being generated, so errors are
+		// tolerated here and only those affecting annotated declarations are reported.
+		Error: func(error) {},
+	}
+	pkg, _ := conf.Check(bpkg.ImportPath, fset, files, info)
+
+	model := &pkgModel{Package: bpkg.Name, Imports: make(map[string]string)}
+	qualifier := func(other *types.Package) string {
+		if other == pkg {
+			return ""
+		}
+		model.Imports[other.Path()] = other.Name()
+		return other.Name()
+	}
+
+	var errs []error
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				doc := ts.Doc
+				if doc == nil && len(gen.Specs) == 1 {
+					doc = gen.Doc
+				}
+				name, ok := annotation(doc)
+				if !ok {
+					continue
+				}
+				sum, err := parseSum(ts, name, info, qualifier)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("%s: %w", fset.Position(ts.Pos()), err))
+					continue
+				}
+				model.Sums = append(model.Sums, sum)
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	sort.Slice(model.Sums, func(i, j int) bool { return model.Sums[i].Name < model.Sums[j].Name })
+	return model, nil
+}
+
+// annotation reports whether the comment group contains the sum directive, and returns the name it specifies.
+func annotation(doc *ast.CommentGroup) (string, bool) {
+	if doc == nil {
+		return "", false
+	}
+	for _, c := range doc.List {
+		if c.Text == directive {
+			return "", true
+		}
+		if rest, ok := strings.CutPrefix(c.Text, directive+" "); ok {
+			return strings.TrimSpace(rest), true
+		}
+	}
+	return "", false
+}
+
+func parseSum(ts *ast.TypeSpec, name string, info *types.Info, qualifier types.Qualifier) (sumType, error) {
+	if ts.TypeParams != nil {
+		return sumType{}, errors.New("generic sum types are not supported")
+	}
+	if name == "" {
+		name = strings.TrimSuffix(ts.Name.Name, "Spec")
+		if name == ts.Name.Name {
+			return sumType{}, fmt.Errorf("%s must end in Spec or name the generated type in its directive", ts.Name.Name)
+		}
+	}
+	if !token.IsIdentifier(name) {
+		return sumType{}, fmt.Errorf("invalid sum type name %q", name)
+	}
+
+	sum := sumType{Name: name}
+	typeOf := func(expr ast.Expr) (string, error) {
+		tv, ok := info.Types[expr]
+		if !ok || tv.Type == nil || tv.Type == types.Typ[types.Invalid] {
+			return "", fmt.Errorf("cannot resolve type %s", types.ExprString(expr))
+		}
+		return types.TypeString(tv.Type, qualifier), nil
+	}
+
+	switch t := ts.Type.(type) {
+	case *ast.StructType:
+		for _, field := range t.Fields.List {
+			if len(field.Names) == 0 {
+				return sumType{}, errors.New("embedded fields cannot be variants")
+			}
+			typ, err := typeOf(field.Type)
+			if err != nil {
+				return sumType{}, err
+			}
+			for _, n := range field.Names {
+				sum.Variants = append(sum.Variants, variant{Name: exported(n.Name), Type: typ})
+			}
+		}
+	case *ast.InterfaceType:
+		for _, method := range t.Methods.List {
+			fn, ok := method.Type.(*ast.FuncType)
+			if !ok || len(method.Names) == 0 {
+				return sumType{}, errors.New("embedded interfaces cannot be variants")
+			}
+			if fn.Results != nil && len(fn.Results.List) > 0 {
+				return sumType{}, fmt.Errorf("variant %s must not have results", method.Names[0].Name)
+			}
+			v := variant{Name: exported(method.Names[0].Name)}
+			switch params := fn.Params.List; {
+			case len(params) == 0:
+			case len(params) == 1 && len(params[0].Names) <= 1:
+				typ, err := typeOf(params[0].Type)
+				if err != nil {
+					return sumType{}, err
+				}
+				v.Type = typ
+			default:
+				return sumType{}, fmt.Errorf("variant %s must have at most one parameter", method.Names[0].Name)
+			}
+			sum.Variants = append(sum.Variants, v)
+		}
+	default:
+		return sumType{}, errors.New("only struct and interface declarations can be annotated")
+	}
+
+	if len(sum.Variants) < 2 {
+		return sumType{}, errors.New("sum types must have at least two variants")
+	}
+	if len(sum.Variants) > 256 {
+		return sumType{}, errors.New("sum types must have at most 256 variants")
+	}
+	seen := make(map[string]bool)
+	for _, v := range sum.Variants {
+		if seen[v.Name] {
+			return sumType{}, fmt.Errorf("duplicate variant %s", v.Name)
+		}
+		seen[v.Name] = true
+	}
+	return sum, nil
+}
+
+func exported(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
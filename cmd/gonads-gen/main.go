@@ -0,0 +1,74 @@
+/*
+Gonads-gen generates sealed sum types with exhaustive matching from annotated declarations.
+
+A declaration is annotated by placing a //gonads:sum directive in its doc comment. The directive may
+name the generated type; if it does not, the declaration's name with its "Spec" suffix removed is used.
+
+Each field of an annotated struct becomes a variant carrying the field's type:
+
+	//gonads:sum
+	type ShapeSpec struct {
+		Circle Circle
+		Square Square
+	}
+
+Each method of an annotated interface becomes a variant carrying the method's single parameter,
+or no value at all if the method has no parameters:
+
+	//gonads:sum Event
+	type event interface {
+		Created(Created)
+		Deleted(Deleted)
+		Reset()
+	}
+
+For each sum type, the generated code contains a constructor per variant, IsX/AsX accessors,
+an exhaustive Match method and Fold function, a MapX method per variant, tagged JSON encoding,
+and, for types with exactly two variants, conversions to and from either.Either.
+
+Usage:
+
+	gonads-gen [-o output] [dir]
+
+The directory defaults to the current directory, making gonads-gen suitable for use with go:generate.
+The output file defaults to gonads_sums.go within that directory.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+const defaultOutput = "gonads_sums.go"
+
+func main() {
+	out := flag.String("o", defaultOutput, "output file, relative to the package directory unless absolute")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: gonads-gen [-o output] [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+	if err := run(dir, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "gonads-gen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(dir, out string) error {
+	src, err := Generate(dir, out)
+	if err != nil {
+		return err
+	}
+	if !filepath.IsAbs(out) {
+		out = filepath.Join(dir, out)
+	}
+	return os.WriteFile(out, src, 0o644)
+}
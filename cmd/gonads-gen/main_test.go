@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestGenerateGolden(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, dir := range dirs {
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			got, err := Generate(dir, defaultOutput)
+			if err != nil {
+				t.Fatalf("Generate() error = %v", err)
+			}
+			golden := filepath.Join(dir, defaultOutput+".golden")
+			if *update {
+				if err := os.WriteFile(golden, got, 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("Generate() output differs from %s; run go test -update to regenerate", golden)
+			}
+			typeCheck(t, dir, got)
+		})
+	}
+}
+
+// typeCheck verifies that the generated code compiles alongside the package it was generated for.
+func typeCheck(t *testing.T, dir string, generated []byte) {
+	t.Helper()
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	f, err := parser.ParseFile(fset, defaultOutput, generated, 0)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v", err)
+	}
+	files = append(files, f)
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(dir, fset, files, nil); err != nil {
+		t.Errorf("generated code does not type-check: %v", err)
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		want string
+	}{
+		{
+			name: "no directives",
+			src:  "type A struct{ X int }",
+			want: "no //gonads:sum declarations",
+		},
+		{
+			name: "missing name",
+			src:  "//gonads:sum\ntype Shape struct{ A int; B string }",
+			want: "must end in Spec",
+		},
+		{
+			name: "single variant",
+			src:  "//gonads:sum\ntype OneSpec struct{ A int }",
+			want: "at least two variants",
+		},
+		{
+			name: "multiple parameters",
+			src:  "//gonads:sum\ntype ESpec interface{ A(int, string); B() }",
+			want: "variant A must have at most one parameter",
+		},
+		{
+			name: "results",
+			src:  "//gonads:sum\ntype ESpec interface{ A() int; B() }",
+			want: "variant A must not have results",
+		},
+		{
+			name: "embedded",
+			src:  "type X struct{}\n//gonads:sum\ntype ESpec struct{ X; B int }",
+			want: "embedded fields cannot be variants",
+		},
+		{
+			name: "duplicate",
+			src:  "//gonads:sum\ntype ESpec interface{ a(); A() }",
+			want: "duplicate variant A",
+		},
+		{
+			name: "unresolved type",
+			src:  "//gonads:sum\ntype ESpec struct{ A Missing; B int }",
+			want: "cannot resolve type Missing",
+		},
+		{
+			name: "generic",
+			src:  "//gonads:sum\ntype ESpec[T any] struct{ A T; B int }",
+			want: "generic sum types are not supported",
+		},
+		{
+			name: "unsupported kind",
+			src:  "//gonads:sum\ntype ESpec int",
+			want: "only struct and interface declarations",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			src := "package p\n\n" + tt.src + "\n"
+			if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Generate(dir, defaultOutput)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Generate() error = %v, want error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestRunIgnoresStaleOutput(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\n//gonads:sum\ntype ESpec struct{ A int; B string }\n"
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := run(dir, defaultOutput); err != nil {
+			t.Fatalf("run() #%d error = %v", i+1, err)
+		}
+	}
+	out, err := os.ReadFile(filepath.Join(dir, defaultOutput))
+	if err != nil || !bytes.Contains(out, []byte("func EA(v int) E")) {
+		t.Errorf("unexpected output: %s, %v", out, err)
+	}
+}
@@ -0,0 +1,237 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+const (
+	optionPath = "github.com/alsi-lawr/gonads/option"
+	eitherPath = "github.com/alsi-lawr/gonads/either"
+)
+
+func render(model *pkgModel) ([]byte, error) {
+	imports := map[string]string{
+		"encoding/json": "json",
+		"fmt":           "fmt",
+	}
+	for _, sum := range model.Sums {
+		if len(sum.Variants) == 2 {
+			imports[eitherPath] = "either"
+		}
+		// As methods, which return an Option, are only generated for variants carrying a value.
+		for _, v := range sum.Variants {
+			if v.Type != "" {
+				imports[optionPath] = "option"
+			}
+		}
+	}
+	for path, name := range model.Imports {
+		imports[path] = name
+	}
+
+	var buf bytes.Buffer
+	if err := fileTemplate.Execute(&buf, struct {
+		Package string
+		Imports [][]string
+		Sums    []sumType
+	}{model.Package, importGroups(imports), model.Sums}); err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return src, nil
+}
+
+// importGroups renders each import as a line of an import block, split into standard library and
+// other imports. Imports whose package name differs from the last element of their path are aliased.
+func importGroups(imports map[string]string) [][]string {
+	var std, other []string
+	for path, name := range imports {
+		line := fmt.Sprintf("%q", path)
+		if path[strings.LastIndex(path, "/")+1:] != name {
+			line = name + " " + line
+		}
+		if strings.Contains(strings.SplitN(path, "/", 2)[0], ".") {
+			other = append(other, line)
+		} else {
+			std = append(std, line)
+		}
+	}
+	byPath := func(lines []string) func(i, j int) bool {
+		return func(i, j int) bool {
+			return lines[i][strings.Index(lines[i], `"`):] < lines[j][strings.Index(lines[j], `"`):]
+		}
+	}
+	sort.Slice(std, byPath(std))
+	sort.Slice(other, byPath(other))
+	return [][]string{std, other}
+}
+
+// Payload returns the type carried by a variant, using the empty struct for unit variants.
+func (v variant) Payload() string {
+	if v.Type == "" {
+		return "struct{}"
+	}
+	return v.Type
+}
+
+// List joins the variant names into an English list.
+func (s sumType) List() string {
+	names := make([]string, len(s.Variants))
+	for i, v := range s.Variants {
+		names[i] = v.Name
+	}
+	if len(names) == 2 {
+		return names[0] + " and " + names[1]
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " and " + names[len(names)-1]
+}
+
+var fileTemplate = template.Must(template.New("file").Parse(`// Code generated by gonads-gen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range $g, $lines := .Imports}}{{if $g}}
+{{end}}{{range $lines}}
+	{{.}}{{end}}{{end}}
+)
+{{range $sum := .Sums}}{{$name := .Name}}
+// {{$name}} is a sum type holding exactly one of the variants {{.List}}.
+//
+// The zero value holds the {{(index .Variants 0).Name}} variant{{if (index .Variants 0).Type}} with a zero value{{end}}.
+type {{$name}} struct {
+	tag uint8
+{{- range $i, $v := .Variants}}{{if .Type}}
+	v{{$i}} {{.Type}}{{end}}{{end}}
+}
+{{range $i, $v := .Variants}}
+// {{$name}}{{.Name}} creates the {{.Name}} variant of {{$name}}.
+func {{$name}}{{.Name}}({{if .Type}}v {{.Type}}{{end}}) {{$name}} {
+	return {{$name}}{tag: {{$i}}{{if .Type}}, v{{$i}}: v{{end}}}
+}
+{{end}}
+{{- range $i, $v := .Variants}}
+// Is{{.Name}} returns true if the {{$name}} holds the {{.Name}} variant.
+func (s {{$name}}) Is{{.Name}}() bool {
+	return s.tag == {{$i}}
+}
+{{if .Type}}
+// As{{.Name}} returns the value of the {{.Name}} variant, if the {{$name}} holds it.
+func (s {{$name}}) As{{.Name}}() option.Option[{{.Type}}] {
+	if s.tag == {{$i}} {
+		return option.Some(s.v{{$i}})
+	}
+	return option.None[{{.Type}}]()
+}
+{{end}}{{end}}
+// Match applies the handler for the variant held by the {{$name}}.
+// A handler must be supplied for every variant, so the match is exhaustive.
+func (s {{$name}}) Match({{range $i, $v := .Variants}}{{if $i}}, {{end}}on{{.Name}} func({{.Type}}){{end}}) {
+	switch s.tag {
+{{- range $i, $v := .Variants}}{{if $i}}
+	case {{$i}}:
+		on{{.Name}}({{if .Type}}s.v{{$i}}{{end}}){{end}}{{end}}
+	default:
+		on{{(index .Variants 0).Name}}({{if (index .Variants 0).Type}}s.v0{{end}})
+	}
+}
+
+// Fold{{$name}} collapses s into a single value by applying the handler for the variant it holds.
+// A handler must be supplied for every variant, so the fold is exhaustive.
+func Fold{{$name}}[U any](s {{$name}}{{range .Variants}}, on{{.Name}} func({{.Type}}) U{{end}}) U {
+	switch s.tag {
+{{- range $i, $v := .Variants}}{{if $i}}
+	case {{$i}}:
+		return on{{.Name}}({{if .Type}}s.v{{$i}}{{end}}){{end}}{{end}}
+	default:
+		return on{{(index .Variants 0).Name}}({{if (index .Variants 0).Type}}s.v0{{end}})
+	}
+}
+{{range $i, $v := .Variants}}{{if .Type}}
+// Map{{.Name}} applies a function to the value of the {{.Name}} variant, if the {{$name}} holds it.
+// If the {{$name}} holds any other variant, it is returned unchanged.
+func (s {{$name}}) Map{{.Name}}(fn func({{.Type}}) {{.Type}}) {{$name}} {
+	if s.tag == {{$i}} {
+		s.v{{$i}} = fn(s.v{{$i}})
+	}
+	return s
+}
+{{end}}{{end}}
+// MarshalJSON implements json.Marshaler.
+//
+// The {{$name}} is encoded as an object with a "tag" discriminator holding the variant name,
+// and a "value" holding the encoded value of that variant.
+func (s {{$name}}) MarshalJSON() ([]byte, error) {
+	var (
+		tag   string
+		value any
+	)
+	switch s.tag {
+{{- range $i, $v := .Variants}}{{if $i}}
+	case {{$i}}:
+		tag{{if .Type}}, value{{end}} = "{{.Name}}"{{if .Type}}, s.v{{$i}}{{end}}{{end}}{{end}}
+	default:
+		tag{{if (index .Variants 0).Type}}, value{{end}} = "{{(index .Variants 0).Name}}"{{if (index .Variants 0).Type}}, s.v0{{end}}
+	}
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct {
+		Tag   string          ` + "`json:\"tag\"`" + `
+		Value json.RawMessage ` + "`json:\"value\"`" + `
+	}{tag, payload})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag names no variant.
+func (s *{{$name}}) UnmarshalJSON(data []byte) error {
+	var tagged struct {
+		Tag   string          ` + "`json:\"tag\"`" + `
+		Value json.RawMessage ` + "`json:\"value\"`" + `
+	}
+	if err := json.Unmarshal(data, &tagged); err != nil {
+		return err
+	}
+	switch tagged.Tag {
+{{- range .Variants}}
+	case "{{.Name}}":{{if .Type}}
+		var v {{.Type}}
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*s = {{$name}}{{.Name}}(v){{else}}
+		*s = {{$name}}{{.Name}}(){{end}}{{end}}
+	default:
+		return fmt.Errorf("{{$name}}: unknown tag %q", tagged.Tag)
+	}
+	return nil
+}
+{{if eq (len .Variants) 2}}{{$l := index .Variants 0}}{{$r := index .Variants 1}}
+// ToEither converts the {{$name}} into an Either, with the {{$l.Name}} variant on the Left
+// and the {{$r.Name}} variant on the Right.
+func (s {{$name}}) ToEither() either.Either[{{$l.Payload}}, {{$r.Payload}}] {
+	if s.tag == 1 {
+		return either.Right[{{$l.Payload}}]({{if $r.Type}}s.v1{{else}}struct{}{}{{end}})
+	}
+	return either.Left[{{$r.Payload}}]({{if $l.Type}}s.v0{{else}}struct{}{}{{end}})
+}
+
+// {{$name}}FromEither converts an Either into the {{$name}} variant it holds, with the Left holding
+// the {{$l.Name}} variant and the Right holding the {{$r.Name}} variant.
+func {{$name}}FromEither(e either.Either[{{$l.Payload}}, {{$r.Payload}}]) {{$name}} {
+	return either.BiMap(e,
+		func({{if $l.Type}}v {{end}}{{$l.Payload}}) {{$name}} { return {{$name}}{{$l.Name}}({{if $l.Type}}v{{end}}) },
+		func({{if $r.Type}}v {{end}}{{$r.Payload}}) {{$name}} { return {{$name}}{{$r.Name}}({{if $r.Type}}v{{end}}) },
+	)
+}
+{{end}}{{end}}`))
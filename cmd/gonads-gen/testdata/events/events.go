@@ -0,0 +1,41 @@
+package events
+
+import "time"
+
+type Created struct {
+	ID string    `json:"id"`
+	At time.Time `json:"at"`
+}
+
+type Deleted struct {
+	ID string `json:"id"`
+}
+
+// Event is emitted whenever a record changes.
+//
+//gonads:sum Event
+type event interface {
+	Created(Created)
+	Deleted(Deleted)
+	Reset()
+}
+
+//gonads:sum
+type LookupSpec struct {
+	Found   time.Duration
+	Missing []string
+}
+
+func describe(e Event) string {
+	return FoldEvent(e,
+		func(c Created) string { return "created " + c.ID },
+		func(d Deleted) string { return "deleted " + d.ID },
+		func() string { return "reset" },
+	)
+}
+
+//gonads:sum
+type ToggleSpec interface {
+	off()
+	on(level int)
+}
@@ -0,0 +1,16 @@
+package states
+
+// State is the lifecycle of a worker.
+//
+//gonads:sum State
+type state interface {
+	Idle()
+	Running()
+}
+
+func busy(s State) bool {
+	return FoldState(s,
+		func() bool { return false },
+		func() bool { return true },
+	)
+}
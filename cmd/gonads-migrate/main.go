@@ -0,0 +1,87 @@
+/*
+Gonads-migrate rewrites functions between the (T, error) convention and result.Result[T].
+
+Given a package directory and a list of functions, it rewrites each function's signature,
+its return statements and every call site of it within the package.
+
+By default, functions returning (T, error) are migrated to result.Result[T]:
+
+	return v, nil                  becomes    return result.Ok(v)
+	return v, errors.New("...")    becomes    return result.Err[T](errors.New("..."))
+	return v, err                  becomes    return result.Lift(func() (T, error) { return v, err })
+	a, err := F()                  becomes    a, err := F().Unpack()
+
+An error is only turned into result.Err when it cannot be nil: a call to errors.New or fmt.Errorf,
+a composite literal, or a variable returned inside an "if err != nil" block that does not reassign it.
+
+With -reverse, functions returning result.Result[T] are migrated back to (T, error):
+
+	return result.Ok(v)      becomes    return v, nil
+	return result.Err[T](e)  becomes    return zero, e
+	r := F()                 becomes    r := result.Lift(F)
+
+Functions are named by their identifier, or as Type.Method for methods. Uses of a migrated function
+that cannot be rewritten safely, such as passing it as a value, are reported for manual attention.
+
+Usage:
+
+	gonads-migrate [-reverse] [-n] -funcs F,T.M [dir]
+
+The directory defaults to the current directory. With -n, the changes are printed as a unified diff
+and no files are written.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+)
+
+func main() {
+	funcs := flag.String("funcs", "", "comma-separated functions to migrate, as Name or Type.Method")
+	reverse := flag.Bool("reverse", false, "migrate result.Result[T] functions back to (T, error)")
+	dryRun := flag.Bool("n", false, "print a diff of the changes instead of writing them")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: gonads-migrate [-reverse] [-n] -funcs F,T.M [dir]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if *funcs == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	res, err := Migrate(dir, strings.Split(*funcs, ","), *reverse)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gonads-migrate:", err)
+		os.Exit(1)
+	}
+	for _, w := range res.Warnings {
+		fmt.Fprintln(os.Stderr, "gonads-migrate: warning:", w)
+	}
+
+	paths := make([]string, 0, len(res.Files))
+	for path := range res.Files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		change := res.Files[path]
+		if *dryRun {
+			fmt.Print(Diff(path, change.Before, change.After))
+			continue
+		}
+		if err := os.WriteFile(path, change.After, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "gonads-migrate:", err)
+			os.Exit(1)
+		}
+	}
+}
@@ -0,0 +1,796 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const resultPath = "github.com/alsi-lawr/gonads/result"
+
+// Change holds the contents of a file before and after migration.
+type Change struct {
+	Before []byte
+	After  []byte
+}
+
+// Migration holds the files changed by a migration, keyed by path, and any uses of the migrated
+// functions that could not be rewritten automatically.
+type Migration struct {
+	Files    map[string]Change
+	Warnings []string
+}
+
+// edit replaces the bytes in [start, end) of a file with text. Insertions have start == end.
+type edit struct {
+	start, end int
+	text       string
+}
+
+type fileState struct {
+	path  string
+	src   []byte
+	file  *ast.File
+	edits []edit
+}
+
+// target is a function being migrated, along with the T of its (T, error) or result.Result[T] signature.
+type target struct {
+	decl  *ast.FuncDecl
+	file  *fileState
+	value types.Type
+}
+
+type migrator struct {
+	fset     *token.FileSet
+	pkg      *types.Package
+	info     *types.Info
+	files    []*fileState
+	reverse  bool
+	targets  map[*types.Func]*target
+	warnings []string
+	err      error
+}
+
+// Migrate rewrites the named functions of the package in dir, along with their call sites.
+// Functions returning (T, error) are migrated to result.Result[T], or back again if reverse is set.
+//
+// The package must type-check before it is migrated. No files are written; the changes are returned instead.
+func Migrate(dir string, names []string, reverse bool) (*Migration, error) {
+	m, err := load(dir, reverse)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.resolve(names); err != nil {
+		return nil, err
+	}
+
+	for _, fs := range m.files {
+		m.rewriteCallSites(fs)
+	}
+	for _, t := range m.targets {
+		m.rewriteDecl(t)
+	}
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	mig := &Migration{Files: make(map[string]Change), Warnings: m.warnings}
+	for _, fs := range m.files {
+		if len(fs.edits) == 0 {
+			continue
+		}
+		after, err := m.apply(fs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", fs.path, err)
+		}
+		mig.Files[fs.path] = Change{Before: fs.src, After: after}
+	}
+	sort.Strings(mig.Warnings)
+	return mig, nil
+}
+
+func load(dir string, reverse bool) (*migrator, error) {
+	bpkg, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &migrator{
+		fset:    token.NewFileSet(),
+		reverse: reverse,
+		targets: make(map[*types.Func]*target),
+		info: &types.Info{
+			Types: make(map[ast.Expr]types.TypeAndValue),
+			Defs:  make(map[*ast.Ident]types.Object),
+			Uses:  make(map[*ast.Ident]types.Object),
+		},
+	}
+	var files []*ast.File
+	for _, name := range append(bpkg.GoFiles, bpkg.TestGoFiles...) {
+		path := filepath.Join(dir, name)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(m.fset, path, src, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+		m.files = append(m.files, &fileState{path: path, src: src, file: f})
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(m.fset, "source", nil)}
+	m.pkg, err = conf.Check(bpkg.ImportPath, m.fset, files, m.info)
+	if err != nil {
+		return nil, fmt.Errorf("package must type-check before migrating: %w", err)
+	}
+	return m, nil
+}
+
+// resolve finds the declaration of each named function and checks that its signature can be migrated.
+func (m *migrator) resolve(names []string) error {
+	wanted := make(map[string]bool)
+	for _, name := range names {
+		if name = strings.TrimSpace(name); name != "" {
+			wanted[name] = true
+		}
+	}
+
+	var errs []error
+	for _, fs := range m.files {
+		for _, decl := range fs.file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || !wanted[funcName(fn)] {
+				continue
+			}
+			delete(wanted, funcName(fn))
+			obj := m.info.Defs[fn.Name].(*types.Func)
+			value, err := m.valueType(obj)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %s: %w", m.fset.Position(fn.Pos()), funcName(fn), err))
+				continue
+			}
+			if fn.Body == nil {
+				errs = append(errs, fmt.Errorf("%s: %s has no body", m.fset.Position(fn.Pos()), funcName(fn)))
+				continue
+			}
+			m.targets[obj] = &target{decl: fn, file: fs, value: value}
+		}
+	}
+	for name := range wanted {
+		errs = append(errs, fmt.Errorf("function %s not found", name))
+	}
+	return errors.Join(errs...)
+}
+
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	recv := fn.Recv.List[0].Type
+	if star, ok := recv.(*ast.StarExpr); ok {
+		recv = star.X
+	}
+	switch r := recv.(type) {
+	case *ast.IndexExpr:
+		recv = r.X
+	case *ast.IndexListExpr:
+		recv = r.X
+	}
+	return types.ExprString(recv) + "." + fn.Name.Name
+}
+
+// valueType returns the T of a function returning (T, error), or of one returning result.Result[T] when reversing.
+func (m *migrator) valueType(fn *types.Func) (types.Type, error) {
+	results := fn.Type().(*types.Signature).Results()
+	if m.reverse {
+		if results.Len() != 1 || !isResult(results.At(0).Type()) {
+			return nil, errors.New("must return result.Result[T]")
+		}
+		return results.At(0).Type().(*types.Named).TypeArgs().At(0), nil
+	}
+	if results.Len() != 2 || !types.Identical(results.At(1).Type(), types.Universe.Lookup("error").Type()) {
+		return nil, errors.New("must return (T, error)")
+	}
+	if results.At(0).Name() != "" || results.At(1).Name() != "" {
+		return nil, errors.New("named results are not supported")
+	}
+	return results.At(0).Type(), nil
+}
+
+func isResult(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == resultPath && named.Obj().Name() == "Result"
+}
+
+// calledTarget returns the migrated function called by the expression, if any.
+func (m *migrator) calledTarget(e ast.Expr) (*target, bool) {
+	call, ok := unparen(e).(*ast.CallExpr)
+	if !ok {
+		return nil, false
+	}
+	var id *ast.Ident
+	switch fn := unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return nil, false
+	}
+	fn, ok := m.info.Uses[id].(*types.Func)
+	if !ok {
+		return nil, false
+	}
+	t, ok := m.targets[fn.Origin()]
+	return t, ok
+}
+
+// rewriteCallSites rewrites every call to a migrated function in the file, and warns about other uses.
+func (m *migrator) rewriteCallSites(fs *fileState) {
+	var stack []ast.Node
+	ast.Inspect(fs.file, func(n ast.Node) bool {
+		if n == nil {
+			stack = stack[:len(stack)-1]
+			return true
+		}
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if _, ok := m.calledTarget(n); ok {
+				if m.reverse {
+					m.reverseCallSite(fs, n, stack)
+				} else {
+					m.forwardCallSite(fs, n, stack)
+				}
+			}
+		case *ast.Ident:
+			fn, ok := m.info.Uses[n].(*types.Func)
+			if ok && m.targets[fn.Origin()] != nil && !isCallee(n, stack) {
+				m.warnings = append(m.warnings, fmt.Sprintf("%s: %s is used as a value and must be updated manually",
+					m.fset.Position(n.Pos()), n.Name))
+			}
+		}
+		stack = append(stack, n)
+		return true
+	})
+}
+
+// isCallee reports whether the identifier is the function being called by the enclosing call expression.
+func isCallee(id *ast.Ident, stack []ast.Node) bool {
+	var fun ast.Node = id
+	i := len(stack) - 1
+	if sel, ok := stack[i].(*ast.SelectorExpr); ok && sel.Sel == id {
+		fun, i = sel, i-1
+	}
+	for ; i >= 0; i-- {
+		switch p := stack[i].(type) {
+		case *ast.ParenExpr:
+			fun = p
+			continue
+		case *ast.IndexExpr:
+			if p.X == fun {
+				fun = p
+				continue
+			}
+		case *ast.IndexListExpr:
+			if p.X == fun {
+				fun = p
+				continue
+			}
+		case *ast.CallExpr:
+			return p.Fun == fun
+		}
+		return false
+	}
+	return false
+}
+
+// forwardCallSite appends .Unpack() to a call whose (T, error) values are used directly.
+func (m *migrator) forwardCallSite(fs *fileState, call *ast.CallExpr, stack []ast.Node) {
+	unpack := false
+	switch p := stack[len(stack)-1].(type) {
+	case *ast.AssignStmt:
+		unpack = len(p.Lhs) == 2
+	case *ast.ValueSpec:
+		unpack = len(p.Names) == 2
+	case *ast.ReturnStmt:
+		_, inTarget := m.enclosingTarget(stack)
+		unpack = !inTarget && len(p.Results) == 1
+	case *ast.CallExpr:
+		unpack = len(p.Args) == 1 && p.Args[0] == call
+	}
+	if unpack {
+		fs.insert(m.offset(call.End()), ".Unpack()")
+	}
+}
+
+// reverseCallSite removes .Unpack() from a call, or lifts the call back into a Result where one is expected.
+func (m *migrator) reverseCallSite(fs *fileState, call *ast.CallExpr, stack []ast.Node) {
+	parent := stack[len(stack)-1]
+	switch p := parent.(type) {
+	case *ast.SelectorExpr:
+		if grand, ok := stack[len(stack)-2].(*ast.CallExpr); ok && p.Sel.Name == "Unpack" && grand.Fun == p && len(grand.Args) == 0 {
+			fs.replace(m.offset(call.End()), m.offset(grand.End()), "")
+			return
+		}
+	case *ast.ReturnStmt:
+		if _, inTarget := m.enclosingTarget(stack); inTarget {
+			return
+		}
+	case *ast.ExprStmt, *ast.GoStmt, *ast.DeferStmt:
+		return
+	}
+
+	name := m.resultName(fs, call.Pos())
+	if len(call.Args) == 0 {
+		fs.insert(m.offset(call.Pos()), name+".Lift(")
+		fs.replace(m.offset(call.Lparen), m.offset(call.End()), ")")
+		return
+	}
+	value := m.info.TypeOf(call).(*types.Named).TypeArgs().At(0)
+	fs.insert(m.offset(call.Pos()), fmt.Sprintf("%s.Lift(func() (%s, error) { return ", name, m.typeString(value)))
+	fs.insert(m.offset(call.End()), " })")
+}
+
+// enclosingTarget returns the migrated function whose body directly encloses the top of the stack.
+func (m *migrator) enclosingTarget(stack []ast.Node) (*target, bool) {
+	for i := len(stack) - 1; i >= 0; i-- {
+		switch fn := stack[i].(type) {
+		case *ast.FuncLit:
+			return nil, false
+		case *ast.FuncDecl:
+			obj, _ := m.info.Defs[fn.Name].(*types.Func)
+			t, ok := m.targets[obj]
+			return t, ok
+		}
+	}
+	return nil, false
+}
+
+// rewriteDecl rewrites the signature and return statements of a migrated function.
+func (m *migrator) rewriteDecl(t *target) {
+	fs := t.file
+	results := t.decl.Type.Results
+	start, end := m.offset(results.Pos()), m.offset(results.End())
+	if m.reverse {
+		index := results.List[0].Type.(*ast.IndexExpr)
+		fs.replace(start, end, "("+fs.text(m, index.Index)+", error)")
+	} else {
+		name := m.resultName(fs, results.Pos())
+		fs.replace(start, end, name+".Result["+fs.text(m, results.List[0].Type)+"]")
+	}
+
+	var stack []ast.Node
+	ast.Inspect(t.decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case nil:
+			stack = stack[:len(stack)-1]
+			return true
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			if m.reverse {
+				m.reverseReturn(t, n)
+			} else {
+				m.forwardReturn(t, n, stack)
+			}
+		}
+		stack = append(stack, n)
+		return true
+	})
+}
+
+func (m *migrator) forwardReturn(t *target, ret *ast.ReturnStmt, stack []ast.Node) {
+	fs := t.file
+	start, end := m.offset(ret.Results[0].Pos()), m.offset(ret.Results[len(ret.Results)-1].End())
+	name := m.resultName(fs, ret.Pos())
+	typ := m.typeString(t.value)
+
+	switch {
+	case len(ret.Results) == 1:
+		if _, ok := m.calledTarget(ret.Results[0]); ok {
+			return
+		}
+		fs.replace(start, end, fmt.Sprintf("%s.Lift(func() (%s, error) { return %s })", name, typ, fs.text(m, ret.Results[0])))
+	case m.info.Types[ret.Results[1]].IsNil():
+		value := ret.Results[0]
+		typeArg := ""
+		if !types.Identical(m.info.TypeOf(value), t.value) {
+			typeArg = "[" + typ + "]"
+		}
+		fs.replace(start, end, fmt.Sprintf("%s.Ok%s(%s)", name, typeArg, fs.text(m, value)))
+	case m.nonNilError(ret.Results[1], stack):
+		fs.replace(start, end, fmt.Sprintf("%s.Err[%s](%s)", name, typ, fs.text(m, ret.Results[1])))
+	default:
+		// The error may be nil at run time, so the pair is lifted rather than assumed to be a failure.
+		fs.replace(start, end, fmt.Sprintf("%s.Lift(func() (%s, error) { return %s, %s })",
+			name, typ, fs.text(m, ret.Results[0]), fs.text(m, ret.Results[1])))
+	}
+}
+
+// nonNilError reports whether a returned error is known to be non-nil: a call to errors.New or fmt.Errorf,
+// a composite literal or its address, or a variable returned from the body of an if statement that checks
+// it is not nil and does not assign to it.
+func (m *migrator) nonNilError(e ast.Expr, stack []ast.Node) bool {
+	switch e := unparen(e).(type) {
+	case *ast.CompositeLit:
+		return true
+	case *ast.UnaryExpr:
+		_, lit := unparen(e.X).(*ast.CompositeLit)
+		return e.Op == token.AND && lit
+	case *ast.CallExpr:
+		sel, ok := unparen(e.Fun).(*ast.SelectorExpr)
+		if !ok {
+			return false
+		}
+		fn, ok := m.info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil {
+			return false
+		}
+		name := fn.Pkg().Path() + "." + fn.Name()
+		return name == "errors.New" || name == "fmt.Errorf"
+	case *ast.Ident:
+		obj, ok := m.info.Uses[e].(*types.Var)
+		if !ok {
+			return false
+		}
+		for i := len(stack) - 1; i > 0; i-- {
+			body, ok := stack[i].(*ast.BlockStmt)
+			if !ok {
+				continue
+			}
+			if ifs, ok := stack[i-1].(*ast.IfStmt); ok && ifs.Body == body && m.checksNonNil(ifs.Cond, obj) {
+				return !m.assigns(body, obj)
+			}
+		}
+	}
+	return false
+}
+
+// checksNonNil reports whether cond can only be true when obj is not nil.
+func (m *migrator) checksNonNil(cond ast.Expr, obj *types.Var) bool {
+	bin, ok := unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return false
+	}
+	switch bin.Op {
+	case token.LAND:
+		return m.checksNonNil(bin.X, obj) || m.checksNonNil(bin.Y, obj)
+	case token.NEQ:
+		is := func(x, y ast.Expr) bool {
+			id, ok := unparen(x).(*ast.Ident)
+			return ok && m.info.Uses[id] == obj && m.info.Types[y].IsNil()
+		}
+		return is(bin.X, bin.Y) || is(bin.Y, bin.X)
+	}
+	return false
+}
+
+// assigns reports whether n assigns to obj, or takes its address, anywhere within it.
+func (m *migrator) assigns(n ast.Node, obj *types.Var) bool {
+	refers := func(e ast.Expr) bool {
+		id, ok := unparen(e).(*ast.Ident)
+		return ok && m.info.Uses[id] == obj
+	}
+	found := false
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				found = found || refers(lhs)
+			}
+		case *ast.RangeStmt:
+			found = found || n.Tok == token.ASSIGN && (n.Key != nil && refers(n.Key) || n.Value != nil && refers(n.Value))
+		case *ast.UnaryExpr:
+			found = found || n.Op == token.AND && refers(n.X)
+		}
+		return !found
+	})
+	return found
+}
+
+func (m *migrator) reverseReturn(t *target, ret *ast.ReturnStmt) {
+	fs := t.file
+	value := ret.Results[0]
+	start, end := m.offset(value.Pos()), m.offset(value.End())
+
+	if call, ok := unparen(value).(*ast.CallExpr); ok && len(call.Args) == 1 {
+		switch m.resultFunc(call) {
+		case "Ok":
+			fs.replace(start, end, fs.text(m, call.Args[0])+", nil")
+			return
+		case "Err":
+			fs.replace(start, end, m.zeroValue(t.value)+", "+fs.text(m, call.Args[0]))
+			return
+		case "Lift":
+			// A closure that only returns a pair, as the forward migration writes, is inlined.
+			if lit, ok := call.Args[0].(*ast.FuncLit); ok && len(lit.Body.List) == 1 {
+				if inner, ok := lit.Body.List[0].(*ast.ReturnStmt); ok && len(inner.Results) == 2 {
+					fs.replace(start, end, fs.text(m, inner.Results[0])+", "+fs.text(m, inner.Results[1]))
+					return
+				}
+			}
+		}
+	}
+	if _, ok := m.calledTarget(value); ok {
+		return
+	}
+	fs.replace(start, end, fs.text(m, value)+".Unpack()")
+}
+
+// resultFunc returns the name of the function in the result package called by the expression, if any.
+func (m *migrator) resultFunc(call *ast.CallExpr) string {
+	fun := unparen(call.Fun)
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun = f.X
+	case *ast.IndexListExpr:
+		fun = f.X
+	}
+	sel, ok := fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+	fn, ok := m.info.Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != resultPath {
+		return ""
+	}
+	return fn.Name()
+}
+
+// resultName returns the name the result package is referred to by at pos, recording an error
+// if that name is shadowed there.
+func (m *migrator) resultName(fs *fileState, pos token.Pos) string {
+	name := "result"
+	for _, spec := range fs.file.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == resultPath && spec.Name != nil {
+			name = spec.Name.Name
+		}
+	}
+	scope := m.pkg.Scope().Innermost(pos)
+	if scope == nil {
+		scope = m.pkg.Scope()
+	}
+	if _, obj := scope.LookupParent(name, pos); obj != nil {
+		if pkgName, ok := obj.(*types.PkgName); !ok || pkgName.Imported().Path() != resultPath {
+			m.fail(fmt.Errorf("%s: %s refers to %s, not the result package; rename it before migrating",
+				m.fset.Position(pos), name, obj))
+		}
+	}
+	return name
+}
+
+func (m *migrator) typeString(t types.Type) string {
+	return types.TypeString(t, func(other *types.Package) string {
+		if other == m.pkg {
+			return ""
+		}
+		return other.Name()
+	})
+}
+
+// zeroValue returns an expression for the zero value of t.
+func (m *migrator) zeroValue(t types.Type) string {
+	if _, ok := t.(*types.TypeParam); ok {
+		return "*new(" + m.typeString(t) + ")"
+	}
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return "false"
+		case u.Info()&types.IsString != 0:
+			return `""`
+		case u.Info()&types.IsNumeric != 0:
+			return "0"
+		}
+		return "nil"
+	case *types.Struct, *types.Array:
+		return m.typeString(t) + "{}"
+	}
+	return "nil"
+}
+
+func (m *migrator) offset(pos token.Pos) int {
+	return m.fset.Position(pos).Offset
+}
+
+func (m *migrator) fail(err error) {
+	if m.err == nil {
+		m.err = err
+	}
+}
+
+func (fs *fileState) insert(at int, text string) {
+	fs.edits = append(fs.edits, edit{start: at, end: at, text: text})
+}
+
+func (fs *fileState) replace(start, end int, text string) {
+	fs.edits = append(fs.edits, edit{start: start, end: end, text: text})
+}
+
+// text returns the source of a node with any edits already made within it applied.
+// Those edits are absorbed into the returned text, so the caller must replace the node's whole extent.
+func (fs *fileState) text(m *migrator, n ast.Node) string {
+	start, end := m.offset(n.Pos()), m.offset(n.End())
+	var inner, outer []edit
+	for _, e := range fs.edits {
+		if e.start >= start && e.end <= end {
+			inner = append(inner, edit{start: e.start - start, end: e.end - start, text: e.text})
+		} else {
+			outer = append(outer, e)
+		}
+	}
+	fs.edits = outer
+	out, err := applyEdits(fs.src[start:end], inner)
+	if err != nil {
+		m.fail(err)
+	}
+	return string(out)
+}
+
+// apply applies the file's edits, adds or removes the result import as needed, and formats the result.
+// The import is only added if the edited file refers to the result package, which a file holding nothing
+// but forward call sites does not.
+func (m *migrator) apply(fs *fileState) ([]byte, error) {
+	out, err := applyEdits(fs.src, fs.edits)
+	if err != nil {
+		return nil, err
+	}
+	if importsResult(fs.file) {
+		if m.reverse {
+			if out, err = removeUnusedResultImport(out); err != nil {
+				return nil, err
+			}
+		}
+		return format.Source(out)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", out, 0)
+	if err != nil {
+		return nil, err
+	}
+	if refersTo(f, "result") {
+		if out, err = applyEdits(fs.src, append(fs.edits, m.importEdit(fs))); err != nil {
+			return nil, err
+		}
+	}
+	return format.Source(out)
+}
+
+func importsResult(f *ast.File) bool {
+	for _, spec := range f.Imports {
+		if path, _ := strconv.Unquote(spec.Path.Value); path == resultPath {
+			return true
+		}
+	}
+	return false
+}
+
+// importEdit returns an edit adding the result package to the file's imports.
+func (m *migrator) importEdit(fs *fileState) edit {
+	line := strconv.Quote(resultPath)
+	for _, decl := range fs.file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		// Join the last group if it holds third-party imports, otherwise start a new one.
+		sep := "\n"
+		if len(gen.Specs) == 0 {
+			continue
+		}
+		if last := gen.Specs[len(gen.Specs)-1].(*ast.ImportSpec); strings.Contains(strings.Split(importPath(last), "/")[0], ".") {
+			sep = ""
+		}
+		if !gen.Rparen.IsValid() {
+			// A single import becomes a group holding both.
+			start, end := m.offset(gen.Pos()), m.offset(gen.End())
+			spec := string(fs.src[m.offset(gen.Specs[0].Pos()):end])
+			return edit{start: start, end: end, text: "import (\n\t" + spec + "\n" + sep + "\t" + line + "\n)"}
+		}
+		at := m.offset(gen.Rparen)
+		return edit{start: at, end: at, text: sep + "\t" + line + "\n"}
+	}
+	at := m.offset(fs.file.Name.End())
+	return edit{start: at, end: at, text: "\n\nimport " + line}
+}
+
+// removeUnusedResultImport removes the result import from the source if nothing refers to it any longer.
+func removeUnusedResultImport(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	var spec *ast.ImportSpec
+	var decl *ast.GenDecl
+	for _, d := range f.Decls {
+		gen, ok := d.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		for _, s := range gen.Specs {
+			if is := s.(*ast.ImportSpec); importPath(is) == resultPath {
+				spec, decl = is, gen
+			}
+		}
+	}
+	if spec == nil {
+		return src, nil
+	}
+	name := "result"
+	if spec.Name != nil {
+		name = spec.Name.Name
+	}
+	if refersTo(f, name) {
+		return src, nil
+	}
+
+	var node ast.Node = spec
+	if len(decl.Specs) == 1 {
+		node = decl
+	}
+	start, end := fset.Position(node.Pos()).Offset, fset.Position(node.End()).Offset
+	return applyEdits(src, []edit{{start: start, end: end}})
+}
+
+// refersTo reports whether the file selects from an unresolved identifier with the given name,
+// which is how a reference to an imported package parses.
+func refersTo(f *ast.File, name string) bool {
+	used := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Name == name && id.Obj == nil {
+				used = true
+			}
+		}
+		return !used
+	})
+	return used
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	path, _ := strconv.Unquote(spec.Path.Value)
+	return path
+}
+
+// applyEdits applies non-overlapping edits to src. Edits at the same offset are applied in the order given.
+func applyEdits(src []byte, edits []edit) ([]byte, error) {
+	sort.SliceStable(edits, func(i, j int) bool { return edits[i].start < edits[j].start })
+	var out bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		if e.start < last {
+			return nil, fmt.Errorf("overlapping edits at offset %d", e.start)
+		}
+		out.Write(src[last:e.start])
+		out.WriteString(e.text)
+		last = e.end
+	}
+	out.Write(src[last:])
+	return out.Bytes(), nil
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
@@ -0,0 +1,230 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+func TestMigrateGolden(t *testing.T) {
+	tests := []struct {
+		dir     string
+		funcs   []string
+		reverse bool
+	}{
+		{
+			dir:   "forward",
+			funcs: []string{"ParseID", "Store.Get", "Lookup", "Passthrough", "Atoi", "Reparse"},
+		},
+		{
+			dir:     "reverse",
+			funcs:   []string{"Parse", "First", "Name", "Count"},
+			reverse: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dir, func(t *testing.T) {
+			dir := filepath.Join("testdata", tt.dir)
+			mig, err := Migrate(dir, tt.funcs, tt.reverse)
+			if err != nil {
+				t.Fatalf("Migrate() error = %v", err)
+			}
+			if len(mig.Warnings) > 0 {
+				t.Errorf("Migrate() warnings = %q, want none", mig.Warnings)
+			}
+
+			for path, change := range mig.Files {
+				golden := path + ".golden"
+				if *update {
+					if err := os.WriteFile(golden, change.After, 0o644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !bytes.Equal(change.After, want) {
+					t.Errorf("Migrate() output differs from %s; run go test -update to regenerate", golden)
+				}
+			}
+			typeCheck(t, dir, mig)
+		})
+	}
+}
+
+// typeCheck verifies that the migrated package still compiles.
+func typeCheck(t *testing.T, dir string, mig *Migration) {
+	t.Helper()
+	fset := token.NewFileSet()
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var files []*ast.File
+	for _, path := range paths {
+		var src any
+		if change, ok := mig.Files[path]; ok {
+			src = change.After
+		}
+		f, err := parser.ParseFile(fset, path, src, 0)
+		if err != nil {
+			t.Fatalf("migrated code does not parse: %v", err)
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	if _, err := conf.Check(dir, fset, files, nil); err != nil {
+		t.Errorf("migrated code does not type-check: %v", err)
+	}
+}
+
+func TestMigrateRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	paths, err := filepath.Glob(filepath.Join("testdata", "forward", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, filepath.Base(path)), src, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write := func(mig *Migration) {
+		for path, change := range mig.Files {
+			if err := os.WriteFile(path, change.After, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	funcs := []string{"ParseID", "Store.Get", "Lookup", "Passthrough", "Atoi", "Reparse"}
+	forward, err := Migrate(dir, funcs, false)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	write(forward)
+	reverse, err := Migrate(dir, funcs, true)
+	if err != nil {
+		t.Fatalf("Migrate(reverse) error = %v", err)
+	}
+	write(reverse)
+
+	typeCheck(t, dir, &Migration{})
+	for _, path := range paths {
+		src, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := os.ReadFile(filepath.Join(dir, filepath.Base(path)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Contains(src, []byte(", error)")) != bytes.Contains(got, []byte(", error)")) || bytes.Contains(got, []byte("result.Result")) {
+			t.Errorf("%s was not migrated back to (T, error):\n%s", filepath.Base(path), got)
+		}
+	}
+}
+
+func TestMigrateWarnings(t *testing.T) {
+	dir := t.TempDir()
+	src := "package p\n\nfunc F() (int, error) { return 0, nil }\n\nvar fs = []func() (int, error){F}\n"
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mig, err := Migrate(dir, []string{"F"}, false)
+	if err != nil {
+		t.Fatalf("Migrate() error = %v", err)
+	}
+	want := []string{filepath.Join(dir, "p.go") + ":5:32: F is used as a value and must be updated manually"}
+	if !reflect.DeepEqual(mig.Warnings, want) {
+		t.Errorf("Migrate() warnings = %q, want %q", mig.Warnings, want)
+	}
+}
+
+func TestMigrateErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     string
+		funcs   []string
+		reverse bool
+		want    string
+	}{
+		{
+			name:  "not found",
+			src:   "package p\n\nfunc F() (int, error) { return 0, nil }\n",
+			funcs: []string{"G"},
+			want:  "function G not found",
+		},
+		{
+			name:  "wrong signature",
+			src:   "package p\n\nfunc F() int { return 0 }\n",
+			funcs: []string{"F"},
+			want:  "must return (T, error)",
+		},
+		{
+			name:  "named results",
+			src:   "package p\n\nfunc F() (n int, err error) { return }\n",
+			funcs: []string{"F"},
+			want:  "named results are not supported",
+		},
+		{
+			name:    "not a result",
+			src:     "package p\n\nfunc F() (int, error) { return 0, nil }\n",
+			funcs:   []string{"F"},
+			reverse: true,
+			want:    "must return result.Result[T]",
+		},
+		{
+			name:  "shadowed",
+			src:   "package p\n\nfunc F() (int, error) {\n\tresult := 1\n\treturn result, nil\n}\n",
+			funcs: []string{"F"},
+			want:  "result refers to var result int",
+		},
+		{
+			name:  "does not type-check",
+			src:   "package p\n\nfunc F() (int, error) { return \"\", nil }\n",
+			funcs: []string{"F"},
+			want:  "package must type-check",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(tt.src), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			_, err := Migrate(dir, tt.funcs, tt.reverse)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Migrate() error = %v, want containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	before := []byte("a\nb\nc\nd\n")
+	after := []byte("a\nB\nc\nd\ne\n")
+	want := "--- a/f.go\n+++ b/f.go\n@@ -1,4 +1,5 @@\n a\n-b\n+B\n c\n d\n+e\n"
+	if got := Diff("f.go", before, after); got != want {
+		t.Errorf("Diff() =\n%s\nwant\n%s", got, want)
+	}
+	if got := Diff("f.go", before, before); got != "" {
+		t.Errorf("Diff() of identical files = %q, want empty", got)
+	}
+}
@@ -0,0 +1,31 @@
+package store
+
+import "strconv"
+
+type RangeError struct {
+	N int
+}
+
+func (e *RangeError) Error() string {
+	return "out of range: " + strconv.Itoa(e.N)
+}
+
+func Atoi(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, err
+	}
+	if v < 0 {
+		return 0, &RangeError{N: v}
+	}
+	return v, err
+}
+
+func Reparse(s string) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		v, err = strconv.Atoi("0" + s)
+		return v, err
+	}
+	return v, nil
+}
@@ -0,0 +1,11 @@
+package store
+
+import "fmt"
+
+func Report(s string) string {
+	id, err := ParseID(s)
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprint(id)
+}
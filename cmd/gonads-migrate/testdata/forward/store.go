@@ -0,0 +1,41 @@
+package store
+
+import (
+	"errors"
+	"strconv"
+)
+
+var ErrNotFound = errors.New("not found")
+
+type Store struct {
+	items map[string]string
+}
+
+func (s *Store) Get(key string) (string, error) {
+	v, ok := s.items[key]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return v, nil
+}
+
+func ParseID(s string) (int64, error) {
+	if s == "" {
+		return 0, errors.New("empty id")
+	}
+	check := func() (bool, error) {
+		return s[0] != '-', nil
+	}
+	if ok, _ := check(); !ok {
+		return 0, errors.New("negative id")
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+func Lookup(s *Store, key string) (int64, error) {
+	raw, err := s.Get(key)
+	if err != nil {
+		return 0, err
+	}
+	return ParseID(raw)
+}
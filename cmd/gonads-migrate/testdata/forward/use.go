@@ -0,0 +1,21 @@
+package store
+
+import (
+	"fmt"
+	"strconv"
+)
+
+var defaultID, defaultErr = ParseID("1")
+
+func Describe(s *Store) string {
+	id, err := Lookup(s, "id")
+	if err != nil {
+		return err.Error()
+	}
+	fmt.Println(s.Get("name"))
+	return strconv.FormatInt(id, 10)
+}
+
+func Passthrough(s string) (int64, error) {
+	return ParseID(s)
+}
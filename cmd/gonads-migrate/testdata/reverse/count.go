@@ -0,0 +1,14 @@
+package point
+
+import (
+	"errors"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func Count(s string) result.Result[int] {
+	if s == "" {
+		return result.Err[int](errors.New("empty"))
+	}
+	return result.Ok(len(s))
+}
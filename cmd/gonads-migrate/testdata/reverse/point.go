@@ -0,0 +1,39 @@
+package point
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+type Point struct {
+	X, Y int
+}
+
+func Parse(s string) result.Result[Point] {
+	if s == "" {
+		return result.Err[Point](errors.New("empty point"))
+	}
+	return result.Ok(Point{X: len(s), Y: strings.Count(s, ",")})
+}
+
+func First[T any](xs []T) result.Result[T] {
+	if len(xs) == 0 {
+		return result.Err[T](errors.New("empty slice"))
+	}
+	return result.Ok(xs[0])
+}
+
+func Name(s string) result.Result[string] {
+	if s == "origin" {
+		return result.Ok("origin")
+	}
+	return named(s)
+}
+
+func named(s string) result.Result[string] {
+	return result.Lift(func() (string, error) {
+		return strings.ToUpper(s), nil
+	})
+}
@@ -0,0 +1,25 @@
+package point
+
+import (
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func Describe(s string) string {
+	p, err := Parse(s).Unpack()
+	if err != nil {
+		return err.Error()
+	}
+	return fmt.Sprint(p)
+}
+
+func Lazy(s string) result.Result[Point] {
+	return Parse(s)
+}
+
+func Defer() {
+	first := First([]int{1, 2})
+	count := Count("abc")
+	fmt.Println(first, count)
+}
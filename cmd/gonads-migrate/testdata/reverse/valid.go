@@ -0,0 +1,6 @@
+package point
+
+func Valid(s string) bool {
+	r := Count(s)
+	return r.IsOk()
+}
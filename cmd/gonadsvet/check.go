@@ -0,0 +1,575 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+)
+
+const gonadsPath = "github.com/alsi-lawr/gonads/"
+
+// Diagnostic is a problem reported at a position in a checked package.
+type Diagnostic struct {
+	Pos     token.Pos
+	Message string
+}
+
+// guardKind is the case a check has established that a value holds.
+type guardKind int
+
+const (
+	guardSome guardKind = iota
+	guardLeft
+	guardRight
+)
+
+// guard records that the value named by recv is known to hold the case kind. root is the variable recv is
+// read from, so that guards on distinct variables with the same name stay apart, and so that assigning to
+// the variable can clear them.
+type guard struct {
+	recv string
+	root types.Object
+	kind guardKind
+}
+
+// facts is the set of guards known to hold at a point in a function.
+type facts map[guard]bool
+
+func (f facts) with(guards []guard) facts {
+	if len(guards) == 0 {
+		return f
+	}
+	next := make(facts, len(f)+len(guards))
+	for g := range f {
+		next[g] = true
+	}
+	for _, g := range guards {
+		next[g] = true
+	}
+	return next
+}
+
+// without returns the facts that still hold after the given variables are assigned to.
+func (f facts) without(assigned map[types.Object]bool) facts {
+	if len(assigned) == 0 {
+		return f
+	}
+	next := make(facts, len(f))
+	for g := range f {
+		if !assigned[g.root] {
+			next[g] = true
+		}
+	}
+	return next
+}
+
+// unwraps maps the pointer-returning accessors to the case they require, keyed by package and method.
+var unwraps = map[[2]string]guard{
+	{"option", "GetOrNil"}:   {kind: guardSome},
+	{"either", "LeftOrNil"}:  {kind: guardLeft},
+	{"either", "RightOrNil"}: {kind: guardRight},
+}
+
+var checkNames = map[guardKind]string{
+	guardSome:  "IsSome",
+	guardLeft:  "IsLeft",
+	guardRight: "IsRight",
+}
+
+type checker struct {
+	pkg   *Package
+	diags []Diagnostic
+	// pointers maps variables last assigned the result of an unwrap to the guard that result requires.
+	pointers map[types.Object]guard
+}
+
+// Check reports discarded results, unchecked unwraps and nil Match handlers in the package.
+func Check(pkg *Package) []Diagnostic {
+	c := &checker{pkg: pkg, pointers: map[types.Object]guard{}}
+	for _, f := range pkg.Files {
+		ast.Walk(visitor{c: c, facts: facts{}}, f)
+	}
+	sort.Slice(c.diags, func(i, j int) bool { return c.diags[i].Pos < c.diags[j].Pos })
+	return c.diags
+}
+
+func (c *checker) report(pos token.Pos, format string, args ...any) {
+	c.diags = append(c.diags, Diagnostic{Pos: pos, Message: fmt.Sprintf(format, args...)})
+}
+
+type visitor struct {
+	c     *checker
+	facts facts
+}
+
+func (v visitor) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.BlockStmt:
+		v.c.stmts(n.List, v.facts)
+		return nil
+	case *ast.CaseClause:
+		for _, e := range n.List {
+			ast.Walk(v, e)
+		}
+		v.c.stmts(n.Body, v.facts)
+		return nil
+	case *ast.SwitchStmt:
+		if n.Tag != nil {
+			break
+		}
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+			v.facts = v.facts.without(v.c.assigned(n.Init))
+		}
+		v.c.tagless(n, v.facts)
+		return nil
+	case *ast.CommClause:
+		if n.Comm != nil {
+			ast.Walk(v, n.Comm)
+		}
+		v.c.stmts(n.Body, v.facts)
+		return nil
+	case *ast.IfStmt:
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+			v.facts = v.facts.without(v.c.assigned(n.Init))
+		}
+		ast.Walk(v, n.Cond)
+		pos, neg := v.c.cond(n.Cond)
+		ast.Walk(visitor{c: v.c, facts: v.facts.with(pos)}, n.Body)
+		if n.Else != nil {
+			ast.Walk(visitor{c: v.c, facts: v.facts.with(neg)}, n.Else)
+		}
+		return nil
+	case *ast.BinaryExpr:
+		if n.Op != token.LAND && n.Op != token.LOR {
+			break
+		}
+		ast.Walk(v, n.X)
+		pos, neg := v.c.cond(n.X)
+		if n.Op == token.LOR {
+			pos = neg
+		}
+		ast.Walk(visitor{c: v.c, facts: v.facts.with(pos)}, n.Y)
+		return nil
+	case *ast.ForStmt:
+		// Guards must hold on every iteration, so anything assigned within the loop is cleared on entry.
+		v.facts = v.facts.without(v.c.assigned(n.Body)).without(v.c.assigned(n.Post))
+	case *ast.RangeStmt:
+		v.facts = v.facts.without(v.c.assigned(n.Body))
+	case *ast.AssignStmt:
+		if len(n.Lhs) == len(n.Rhs) {
+			for i, lhs := range n.Lhs {
+				v.c.trackPointer(lhs, n.Rhs[i])
+			}
+		}
+	case *ast.ValueSpec:
+		if len(n.Names) == len(n.Values) {
+			for i, name := range n.Names {
+				v.c.trackPointer(name, n.Values[i])
+			}
+		}
+	case *ast.ExprStmt:
+		v.c.checkDiscard(n)
+	case *ast.StarExpr:
+		v.c.checkUnwrap(n.X, v.facts)
+	case *ast.SelectorExpr:
+		if v.c.derefs(n) {
+			v.c.checkUnwrap(n.X, v.facts)
+		}
+	case *ast.CallExpr:
+		v.c.checkMatch(n)
+	}
+	return v
+}
+
+// stmts walks a statement list, carrying forward the guards established by early exits,
+// such as if opt.IsNone() { return }.
+func (c *checker) stmts(list []ast.Stmt, f facts) {
+	for _, s := range list {
+		ast.Walk(visitor{c: c, facts: f}, s)
+		f = f.without(c.assigned(s))
+		switch s := s.(type) {
+		case *ast.IfStmt:
+			if s.Else == nil && c.terminates(s.Body.List) {
+				_, neg := c.cond(s.Cond)
+				f = f.with(neg)
+			}
+		case *ast.SwitchStmt:
+			if s.Tag == nil {
+				f = f.with(c.afterSwitch(s))
+			}
+		}
+	}
+}
+
+// tagless walks the clauses of a switch without a tag. Reaching a case means every earlier case was false,
+// so each clause is checked with the guards of its own case and the negations of those before it.
+// A default clause is only reached once every other case is false.
+func (c *checker) tagless(sw *ast.SwitchStmt, f facts) {
+	if fallsThrough(sw) {
+		// A body reached by fallthrough holds none of its own case's guards.
+		ast.Walk(visitor{c: c, facts: f}, sw.Body)
+		return
+	}
+	earlier := f
+	var def *ast.CaseClause
+	for _, s := range sw.Body.List {
+		cc := s.(*ast.CaseClause)
+		if cc.List == nil {
+			def = cc
+			continue
+		}
+		for _, e := range cc.List {
+			ast.Walk(visitor{c: c, facts: earlier}, e)
+		}
+		pos, neg := c.caseCond(cc)
+		c.stmts(cc.Body, earlier.with(pos))
+		earlier = earlier.with(neg)
+	}
+	if def != nil {
+		c.stmts(def.Body, earlier)
+	}
+}
+
+// afterSwitch returns the guards known to hold after a switch without a tag. Leaving the switch through
+// a clause that does not exit the enclosing statement list means every case before it was false, and
+// leaving it with no case taken means every case was false.
+func (c *checker) afterSwitch(sw *ast.SwitchStmt) []guard {
+	if fallsThrough(sw) {
+		return nil
+	}
+	var out []guard
+	for _, s := range sw.Body.List {
+		cc := s.(*ast.CaseClause)
+		if cc.List == nil {
+			continue
+		}
+		if !c.terminates(cc.Body) || endsWith(cc.Body, token.BREAK) {
+			break
+		}
+		_, neg := c.caseCond(cc)
+		out = append(out, neg...)
+	}
+	return out
+}
+
+// caseCond returns the guards established when a case of a switch without a tag is taken, and when it is not.
+func (c *checker) caseCond(cc *ast.CaseClause) (pos, neg []guard) {
+	for _, e := range cc.List {
+		p, n := c.cond(e)
+		if len(cc.List) == 1 {
+			pos = p
+		}
+		neg = append(neg, n...)
+	}
+	return pos, neg
+}
+
+// fallsThrough reports whether any clause of the switch falls through into the next.
+func fallsThrough(sw *ast.SwitchStmt) bool {
+	for _, s := range sw.Body.List {
+		if endsWith(s.(*ast.CaseClause).Body, token.FALLTHROUGH) {
+			return true
+		}
+	}
+	return false
+}
+
+// endsWith reports whether a statement list ends in an unlabelled branch statement of the given kind.
+func endsWith(list []ast.Stmt, tok token.Token) bool {
+	if len(list) == 0 {
+		return false
+	}
+	br, ok := list[len(list)-1].(*ast.BranchStmt)
+	return ok && br.Tok == tok && br.Label == nil
+}
+
+// terminates reports whether a statement list always leaves the enclosing statement list.
+func (c *checker) terminates(list []ast.Stmt) bool {
+	if len(list) == 0 {
+		return false
+	}
+	switch s := list[len(list)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		call, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		switch fn := unparen(call.Fun).(type) {
+		case *ast.Ident:
+			_, builtin := c.pkg.Info.Uses[fn].(*types.Builtin)
+			return builtin && fn.Name == "panic"
+		case *ast.SelectorExpr:
+			obj, ok := c.pkg.Info.Uses[fn.Sel].(*types.Func)
+			if !ok || obj.Pkg() == nil {
+				return false
+			}
+			name := obj.Pkg().Path() + "." + obj.Name()
+			return name == "os.Exit" || strings.HasPrefix(name, "log.Fatal") || strings.HasPrefix(name, "log.Panic")
+		}
+	}
+	return false
+}
+
+// cond returns the guards established when the condition is true, and when it is false.
+func (c *checker) cond(e ast.Expr) (pos, neg []guard) {
+	switch e := unparen(e).(type) {
+	case *ast.UnaryExpr:
+		if e.Op == token.NOT {
+			pos, neg = c.cond(e.X)
+			return neg, pos
+		}
+	case *ast.BinaryExpr:
+		switch e.Op {
+		case token.LAND:
+			px, _ := c.cond(e.X)
+			py, _ := c.cond(e.Y)
+			return append(px, py...), nil
+		case token.LOR:
+			_, nx := c.cond(e.X)
+			_, ny := c.cond(e.Y)
+			return nil, append(nx, ny...)
+		case token.NEQ, token.EQL:
+			// opt.GetOrNil() != nil guards the unwrap just like opt.IsSome() does.
+			call, other := e.X, e.Y
+			if c.pkg.Info.Types[call].IsNil() {
+				call, other = other, call
+			}
+			if !c.pkg.Info.Types[other].IsNil() {
+				return nil, nil
+			}
+			g, ok := c.unwrapGuard(call)
+			if !ok {
+				return nil, nil
+			}
+			if e.Op == token.NEQ {
+				return []guard{g}, nil
+			}
+			return nil, []guard{g}
+		}
+	case *ast.CallExpr:
+		sel, recv, ok := c.gonadsMethod(e)
+		if !ok || len(e.Args) != 0 {
+			return nil, nil
+		}
+		root := c.root(sel.X)
+		some, left, right := guard{recv, root, guardSome}, guard{recv, root, guardLeft}, guard{recv, root, guardRight}
+		switch sel.Sel.Name {
+		case "IsSome":
+			return []guard{some}, nil
+		case "IsNone":
+			return nil, []guard{some}
+		case "IsLeft":
+			return []guard{left}, []guard{right}
+		case "IsRight":
+			return []guard{right}, []guard{left}
+		}
+	}
+	return nil, nil
+}
+
+// gonadsMethod returns the selector and receiver of a call to a method declared in a gonads package.
+func (c *checker) gonadsMethod(call *ast.CallExpr) (*ast.SelectorExpr, string, bool) {
+	sel, ok := unparen(call.Fun).(*ast.SelectorExpr)
+	if !ok {
+		return nil, "", false
+	}
+	selection, ok := c.pkg.Info.Selections[sel]
+	if !ok || selection.Kind() != types.MethodVal || gonadsPackage(selection.Recv()) == "" {
+		return nil, "", false
+	}
+	return sel, types.ExprString(unparen(sel.X)), true
+}
+
+// unwrapGuard returns the guard required before dereferencing the result of a GetOrNil,
+// LeftOrNil or RightOrNil call, or a variable holding one.
+func (c *checker) unwrapGuard(e ast.Expr) (guard, bool) {
+	if id, ok := unparen(e).(*ast.Ident); ok {
+		g, ok := c.pointers[c.pkg.Info.Uses[id]]
+		return g, ok
+	}
+	call, ok := unparen(e).(*ast.CallExpr)
+	if !ok {
+		return guard{}, false
+	}
+	sel, recv, ok := c.gonadsMethod(call)
+	if !ok {
+		return guard{}, false
+	}
+	g, ok := unwraps[[2]string{gonadsPackage(c.pkg.Info.Selections[sel].Recv()), sel.Sel.Name}]
+	g.recv, g.root = recv, c.root(sel.X)
+	return g, ok
+}
+
+// trackPointer records whether the variable assigned by lhs now holds the result of an unwrap.
+func (c *checker) trackPointer(lhs, rhs ast.Expr) {
+	id, ok := unparen(lhs).(*ast.Ident)
+	if !ok {
+		return
+	}
+	obj := c.pkg.Info.Defs[id]
+	if obj == nil {
+		obj = c.pkg.Info.Uses[id]
+	}
+	if obj == nil {
+		return
+	}
+	if g, ok := c.unwrapGuard(rhs); ok {
+		c.pointers[obj] = g
+		return
+	}
+	delete(c.pointers, obj)
+}
+
+// root returns the variable an expression such as a, a.b or a[i].c reads from, or nil if it is not read from one.
+func (c *checker) root(e ast.Expr) types.Object {
+	for {
+		switch x := unparen(e).(type) {
+		case *ast.Ident:
+			return c.pkg.Info.Uses[x]
+		case *ast.SelectorExpr:
+			e = x.X
+		case *ast.IndexExpr:
+			e = x.X
+		case *ast.StarExpr:
+			e = x.X
+		default:
+			return nil
+		}
+	}
+}
+
+// assigned returns the variables that n may change: those assigned to, incremented, or whose address is taken.
+func (c *checker) assigned(n ast.Node) map[types.Object]bool {
+	if n == nil {
+		return nil
+	}
+	out := map[types.Object]bool{}
+	add := func(e ast.Expr) {
+		if e == nil {
+			return
+		}
+		if obj := c.root(e); obj != nil {
+			out[obj] = true
+		}
+	}
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				add(lhs)
+			}
+		case *ast.IncDecStmt:
+			add(n.X)
+		case *ast.RangeStmt:
+			if n.Tok == token.ASSIGN {
+				add(n.Key)
+				add(n.Value)
+			}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				add(n.X)
+			}
+		}
+		return true
+	})
+	return out
+}
+
+// derefs reports whether the selector implicitly dereferences its operand, as a field access
+// or value method call through a pointer does.
+func (c *checker) derefs(sel *ast.SelectorExpr) bool {
+	selection, ok := c.pkg.Info.Selections[sel]
+	if !ok {
+		return false
+	}
+	if _, ptr := selection.Recv().Underlying().(*types.Pointer); !ptr {
+		return false
+	}
+	if selection.Kind() == types.FieldVal {
+		return true
+	}
+	sig := selection.Obj().Type().(*types.Signature)
+	_, ptrRecv := sig.Recv().Type().Underlying().(*types.Pointer)
+	return !ptrRecv
+}
+
+func (c *checker) checkUnwrap(e ast.Expr, f facts) {
+	g, ok := c.unwrapGuard(e)
+	if !ok || f[g] {
+		return
+	}
+	c.report(e.Pos(), "%s dereferenced without checking %s.%s() first",
+		types.ExprString(unparen(e)), g.recv, checkNames[g.kind])
+}
+
+func (c *checker) checkDiscard(s *ast.ExprStmt) {
+	call, ok := unparen(s.X).(*ast.CallExpr)
+	if !ok {
+		return
+	}
+	tv, ok := c.pkg.Info.Types[call]
+	if !ok || !isGonadsType(tv.Type, "result", "Result") {
+		return
+	}
+	// Side-effect combinators return their receiver, so discarding them only drops
+	// the Result when the receiver is itself a call.
+	if sel, _, ok := c.gonadsMethod(call); ok {
+		switch sel.Sel.Name {
+		case "Tap", "TapErr", "Inspect":
+			if _, isCall := unparen(sel.X).(*ast.CallExpr); !isCall {
+				return
+			}
+		}
+	}
+	c.report(call.Pos(), "result of %s is discarded, dropping any error it holds", types.ExprString(call.Fun))
+}
+
+func (c *checker) checkMatch(call *ast.CallExpr) {
+	sel, _, ok := c.gonadsMethod(call)
+	if !ok || sel.Sel.Name != "Match" {
+		return
+	}
+	for i, arg := range call.Args {
+		if c.pkg.Info.Types[arg].IsNil() {
+			c.report(arg.Pos(), "nil handler passed as argument %d of Match; it panics if that case is reached", i+1)
+		}
+	}
+}
+
+// gonadsPackage returns the name of the gonads package that declares t, or of its element type if t is a pointer.
+func gonadsPackage(t types.Type) string {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return ""
+	}
+	path, ok := strings.CutPrefix(named.Obj().Pkg().Path(), gonadsPath)
+	if !ok {
+		return ""
+	}
+	return path
+}
+
+func isGonadsType(t types.Type, pkg, name string) bool {
+	named, ok := t.(*types.Named)
+	return ok && gonadsPackage(named) == pkg && named.Obj().Name() == name
+}
+
+func unparen(e ast.Expr) ast.Expr {
+	for {
+		p, ok := e.(*ast.ParenExpr)
+		if !ok {
+			return e
+		}
+		e = p.X
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var wantRe = regexp.MustCompile("// want `([^`]*)`")
+
+func TestCheckFixture(t *testing.T) {
+	dir := filepath.Join("testdata", "src", "fixture")
+	paths, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg, err := loadFiles("fixture", paths)
+	if err != nil {
+		t.Fatalf("loadFiles() error = %v", err)
+	}
+
+	want := make(map[string]*regexp.Regexp)
+	for _, path := range paths {
+		for key, re := range wantComments(t, path) {
+			want[key] = re
+		}
+	}
+
+	for _, d := range Check(pkg) {
+		pos := pkg.Fset.Position(d.Pos)
+		key := fmt.Sprintf("%s:%d", pos.Filename, pos.Line)
+		re, ok := want[key]
+		if !ok {
+			t.Errorf("%s: unexpected diagnostic: %s", key, d.Message)
+			continue
+		}
+		if !re.MatchString(d.Message) {
+			t.Errorf("%s: diagnostic %q does not match %q", key, d.Message, re)
+		}
+		delete(want, key)
+	}
+	for key, re := range want {
+		t.Errorf("%s: missing diagnostic matching %q", key, re)
+	}
+}
+
+func wantComments(t *testing.T, path string) map[string]*regexp.Regexp {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	want := make(map[string]*regexp.Regexp)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		m := wantRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+		want[path+":"+strconv.Itoa(line)] = regexp.MustCompile(m[1])
+	}
+	return want
+}
+
+func TestLoadPackages(t *testing.T) {
+	pkgs, err := loadPackages([]string{"github.com/alsi-lawr/gonads/option"})
+	if err != nil {
+		t.Fatalf("loadPackages() error = %v", err)
+	}
+	if len(pkgs) != 1 || pkgs[0].Types.Name() != "option" {
+		t.Fatalf("expected the option package, got %v", pkgs)
+	}
+	if diags := Check(pkgs[0]); len(diags) != 0 {
+		t.Errorf("expected no diagnostics in the option package, got %v", diags)
+	}
+}
+
+func TestLoadPackagesError(t *testing.T) {
+	_, err := loadPackages([]string{"github.com/alsi-lawr/gonads/does-not-exist"})
+	if err == nil || !strings.Contains(err.Error(), "does-not-exist") {
+		t.Errorf("expected error naming the missing package, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"os/exec"
+	"path/filepath"
+)
+
+// Package is a parsed and type-checked package ready to be checked.
+type Package struct {
+	Fset  *token.FileSet
+	Files []*ast.File
+	Types *types.Package
+	Info  *types.Info
+}
+
+// loadPackages resolves the patterns with go list, then parses and type-checks each matching package.
+func loadPackages(patterns []string) ([]*Package, error) {
+	args := append([]string{"list", "-json=Dir,ImportPath,GoFiles,Error"}, patterns...)
+	cmd := exec.Command("go", args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, stderr.Bytes())
+	}
+
+	var pkgs []*Package
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var listed struct {
+			Dir        string
+			ImportPath string
+			GoFiles    []string
+			Error      *struct{ Err string }
+		}
+		if err := dec.Decode(&listed); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		if listed.Error != nil {
+			return nil, fmt.Errorf("%s: %s", listed.ImportPath, listed.Error.Err)
+		}
+		paths := make([]string, len(listed.GoFiles))
+		for i, name := range listed.GoFiles {
+			paths[i] = filepath.Join(listed.Dir, name)
+		}
+		pkg, err := loadFiles(listed.ImportPath, paths)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, pkg)
+	}
+	return pkgs, nil
+}
+
+// loadFiles parses and type-checks the named files as a single package.
+func loadFiles(importPath string, paths []string) (*Package, error) {
+	fset := token.NewFileSet()
+	files := make([]*ast.File, 0, len(paths))
+	for _, path := range paths {
+		f, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+
+	info := &types.Info{
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
+	}
+	var errs []error
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { errs = append(errs, err) },
+	}
+	pkg, _ := conf.Check(importPath, fset, files, info)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return &Package{Fset: fset, Files: files, Types: pkg, Info: info}, nil
+}
@@ -0,0 +1,53 @@
+/*
+Gonadsvet reports unsafe uses of the gonads monads.
+
+It checks for:
+
+	Discarded results: a call returning result.Result used as a statement, silently dropping any error.
+	Unchecked unwraps: a GetOrNil, LeftOrNil or RightOrNil call, or a variable assigned from one,
+	    dereferenced without first being guarded by an IsSome, IsNone, IsLeft or IsRight check on the
+	    same value. A guard no longer applies once the value is assigned to.
+	Nil handlers: a Match call passed nil for one of its handlers, which panics when that case is hit.
+
+Usage:
+
+	gonadsvet [packages]
+
+Packages are named as for the go command, and default to the package in the current directory.
+Gonadsvet exits with status 1 if any problems are reported, and 2 if the packages could not be loaded.
+*/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: gonadsvet [packages]")
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	pkgs, err := loadPackages(patterns)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "gonadsvet:", err)
+		os.Exit(2)
+	}
+
+	found := false
+	for _, pkg := range pkgs {
+		for _, d := range Check(pkg) {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", pkg.Fset.Position(d.Pos), d.Message)
+			found = true
+		}
+	}
+	if found {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,178 @@
+package fixture
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+type user struct{ Name string }
+
+func (u user) Greeting() string { return "hello " + u.Name }
+
+func save() result.Result[int] { return result.Err[int](errors.New("disk full")) }
+
+func find() option.Option[user] { return option.None[user]() }
+
+func discarded() {
+	save()                         // want `result of save is discarded`
+	result.Ok(1).Tap(func(int) {}) // want `result of result.Ok\(1\).Tap is discarded`
+	save().TapErr(func(error) {})  // want `result of save\(\).TapErr is discarded`
+	_ = save()
+	r := save()
+	r.Inspect(func(result.Result[int]) {})
+	fmt.Println(r.IsOk())
+}
+
+func unchecked(opt option.Option[int], e either.Either[string, int]) int {
+	n := *opt.GetOrNil()                      // want `opt.GetOrNil\(\) dereferenced without checking opt.IsSome\(\) first`
+	n += len(*e.LeftOrNil())                  // want `e.LeftOrNil\(\) dereferenced without checking e.IsLeft\(\) first`
+	n += *e.RightOrNil()                      // want `e.RightOrNil\(\) dereferenced without checking e.IsRight\(\) first`
+	fmt.Println(find().GetOrNil().Name)       // want `find\(\).GetOrNil\(\) dereferenced without checking find\(\).IsSome\(\) first`
+	fmt.Println(find().GetOrNil().Greeting()) // want `find\(\).GetOrNil\(\) dereferenced`
+	if other := option.Some(1); other.IsSome() {
+		n += *opt.GetOrNil() // want `opt.GetOrNil\(\) dereferenced`
+	}
+	return n
+}
+
+func checked(opt option.Option[int], e either.Either[string, int], u option.Option[user]) int {
+	n := 0
+	if opt.IsSome() {
+		n += *opt.GetOrNil()
+	}
+	if !opt.IsNone() && *opt.GetOrNil() > 0 {
+		n++
+	}
+	if opt.IsNone() || *opt.GetOrNil() == 0 {
+		n--
+	}
+	if e.IsLeft() {
+		n += len(*e.LeftOrNil())
+	} else {
+		n += *e.RightOrNil()
+	}
+	if !e.IsRight() {
+		n += len(*e.LeftOrNil())
+	}
+	if u.GetOrNil() != nil {
+		fmt.Println(u.GetOrNil().Name)
+	}
+	if p := opt.GetOrNil(); p != nil {
+		n += *p
+	}
+	if opt.IsNone() {
+		return n
+	}
+	return n + *opt.GetOrNil()
+}
+
+func earlyPanic(opt option.Option[int]) int {
+	if !opt.IsSome() {
+		panic("missing")
+	}
+	return *opt.GetOrNil()
+}
+
+func earlyExitInLoop(opts []option.Option[int]) int {
+	n := 0
+	for _, opt := range opts {
+		if opt.IsNone() {
+			continue
+		}
+		n += *opt.GetOrNil()
+	}
+	return n
+}
+
+func matches(opt option.Option[int], r result.Result[int], e either.Either[string, int]) {
+	opt.Match(func(int) {}, nil) // want `nil handler passed as argument 2 of Match`
+	r.Match(nil, func(error) {}) // want `nil handler passed as argument 1 of Match`
+	e.Match(func(string) {}, func(int) {})
+	var none func()
+	opt.Match(func(int) {}, none)
+}
+
+func pointers(opt option.Option[int], e either.Either[string, int]) int {
+	p := opt.GetOrNil()
+	n := *p // want `p dereferenced without checking opt.IsSome\(\) first`
+	if opt.IsSome() {
+		n += *p
+	}
+	if p != nil {
+		n += *p
+	}
+	var l = e.LeftOrNil()
+	n += len(*l) // want `l dereferenced without checking e.IsLeft\(\) first`
+	q := p
+	n += *q // want `q dereferenced without checking opt.IsSome\(\) first`
+	p = &n
+	return n + *p
+}
+
+func reassigned(opt, other option.Option[int]) int {
+	n := 0
+	if opt.IsSome() {
+		opt = other
+		n += *opt.GetOrNil() // want `opt.GetOrNil\(\) dereferenced`
+	}
+	if opt.IsNone() {
+		return n
+	}
+	for i := 0; i < 3; i++ {
+		n += *opt.GetOrNil() // want `opt.GetOrNil\(\) dereferenced`
+		opt = other
+	}
+	return n
+}
+
+func shadowed(opt option.Option[int], opts []option.Option[int]) int {
+	n := 0
+	if opt.IsSome() {
+		for _, opt := range opts {
+			n += *opt.GetOrNil() // want `opt.GetOrNil\(\) dereferenced`
+		}
+	}
+	return n
+}
+
+func tagless(opt option.Option[int], e either.Either[string, int]) int {
+	switch {
+	case opt.IsSome():
+		return *opt.GetOrNil()
+	case e.IsLeft():
+		return len(*e.LeftOrNil())
+	default:
+		return *e.RightOrNil()
+	}
+}
+
+func taglessExits(a, b option.Option[int]) int {
+	switch {
+	case a.IsNone():
+		return 0
+	case b.IsNone():
+		return *a.GetOrNil()
+	}
+	return *a.GetOrNil() + *b.GetOrNil()
+}
+
+func taglessUnchecked(a, b option.Option[int]) int {
+	n := 0
+	switch {
+	case a.IsSome(), b.IsSome():
+		n += *a.GetOrNil() // want `a.GetOrNil\(\) dereferenced`
+	case a.IsNone():
+		n++
+	}
+	switch {
+	case a.IsNone():
+		break
+	case b.IsNone():
+		return n
+	}
+	return n + *a.GetOrNil() // want `a.GetOrNil\(\) dereferenced`
+}
@@ -72,3 +72,140 @@ func BiBindMap[L, R, U, V any](e Either[L, R], l func(L) U, r func(R) V) Either[
 	}
 	return Right[U](r(e.right))
 }
+
+// Inspect runs a side effect on the Either itself, whether it is Left or Right.
+//
+// Type signature:
+//
+//	Inspect :: Either L R -> (Either L R -> ()) -> Either L R
+//
+// It returns the Either unchanged, allowing the side effect to be inserted into a chain.
+func (e Either[L, R]) Inspect(fn func(Either[L, R])) Either[L, R] {
+	fn(e)
+	return e
+}
+
+// Swap exchanges the Left and Right sides of the Either.
+//
+// Type signature:
+//
+//	Swap :: Either L R -> Either R L
+//
+// A Left becomes a Right holding the same value, and a Right becomes a Left.
+func Swap[L, R any](e Either[L, R]) Either[R, L] {
+	if e.isLeft {
+		return Right[R](e.left)
+	}
+	return Left[L](e.right)
+}
+
+// Swap exchanges the Left and Right sides of the Either.
+//
+// Type signature:
+//
+//	Swap :: Either L R -> Either R L
+//
+// A Left becomes a Right holding the same value, and a Right becomes a Left.
+func (e Either[L, R]) Swap() Either[R, L] {
+	return Swap(e)
+}
+
+// Fold collapses the Either into a single value by applying one of two functions.
+//
+// Type signature:
+//
+//	Fold :: Either L R -> (L -> U) -> (R -> U) -> U
+//
+// If the Either is Left, it applies the onLeft function to the left value.
+// If the Either is Right, it applies the onRight function to the right value.
+func Fold[L, R, U any](e Either[L, R], onLeft func(L) U, onRight func(R) U) U {
+	return BiMap(e, onLeft, onRight)
+}
+
+// Fold collapses the Either into a value of the Right type by applying one of two functions.
+//
+// Type signature:
+//
+//	Fold :: Either L R -> (L -> R) -> (R -> R) -> R
+//
+// If the Either is Left, it applies the onLeft function to the left value.
+// If the Either is Right, it applies the onRight function to the right value.
+func (e Either[L, R]) Fold(onLeft func(L) R, onRight func(R) R) R {
+	return BiMap(e, onLeft, onRight)
+}
+
+// Map applies a function to the Right value if it exists, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map :: Either L R -> (R -> U) -> Either L U
+//
+// It is equivalent to RMap.
+func Map[L, R, U any](e Either[L, R], fn func(R) U) Either[L, U] {
+	return RMap(e, fn)
+}
+
+// Map applies a function to the Right value if it exists, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map :: Either L R -> (R -> R) -> Either L R
+//
+// It is equivalent to RMap.
+func (e Either[L, R]) Map(fn func(R) R) Either[L, R] {
+	return RMap(e, fn)
+}
+
+// Bind applies a function to the Right value if it exists, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Bind :: Either L R -> (R -> Either L U) -> Either L U
+//
+// It is equivalent to RBind.
+func Bind[L, R, U any](e Either[L, R], fn func(R) Either[L, U]) Either[L, U] {
+	return RBind(e, fn)
+}
+
+// Bind applies a function to the Right value if it exists, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Bind :: Either L R -> (R -> Either L R) -> Either L R
+//
+// It is equivalent to RBind.
+func (e Either[L, R]) Bind(fn func(R) Either[L, R]) Either[L, R] {
+	return RBind(e, fn)
+}
+
+// Sequence turns a slice of Eithers into an Either of a slice, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Sequence :: [Either L R] -> Either L [R]
+//
+// If every Either is Right, it returns a Right holding all right values in order.
+// Otherwise, it returns the first Left encountered.
+func Sequence[L, R any](es []Either[L, R]) Either[L, []R] {
+	return Traverse(es, func(e Either[L, R]) Either[L, R] { return e })
+}
+
+// Traverse applies a function returning an Either to each element of a slice, collecting the results.
+//
+// Type signature:
+//
+//	Traverse :: [T] -> (T -> Either L R) -> Either L [R]
+//
+// If fn returns Right for every element, it returns a Right holding all right values in order.
+// Otherwise, it stops at and returns the first Left.
+func Traverse[T, L, R any](s []T, fn func(T) Either[L, R]) Either[L, []R] {
+	rights := make([]R, 0, len(s))
+	for _, v := range s {
+		e := fn(v)
+		if e.isLeft {
+			return Left[[]R](e.left)
+		}
+		rights = append(rights, e.right)
+	}
+	return Right[L](rights)
+}
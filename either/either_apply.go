@@ -0,0 +1,99 @@
+package either
+
+// Apply applies a function wrapped in an Either to a value wrapped in an Either, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Apply :: Either L (a -> b) -> Either L a -> Either L b
+//
+// If both Eithers are Right, it returns a Right with the result of the function.
+// Otherwise, it returns the first Left in argument order.
+func Apply[L, T, U any](fn Either[L, func(T) U], e Either[L, T]) Either[L, U] {
+	switch {
+	case fn.isLeft:
+		return Left[U](fn.left)
+	case e.isLeft:
+		return Left[U](e.left)
+	}
+	return Right[L](fn.right(e.right))
+}
+
+// Map2 combines 2 Eithers with a function of 2 arguments, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map2 :: Either L a -> Either L b -> (a -> b -> r) -> Either L r
+//
+// If every Either is Right, it returns a Right with the result of fn. Otherwise, it returns the first Left in argument order.
+func Map2[L, T1, T2, U any](e1 Either[L, T1], e2 Either[L, T2], fn func(T1, T2) U) Either[L, U] {
+	switch {
+	case e1.isLeft:
+		return Left[U](e1.left)
+	case e2.isLeft:
+		return Left[U](e2.left)
+	}
+	return Right[L](fn(e1.right, e2.right))
+}
+
+// Map3 combines 3 Eithers with a function of 3 arguments, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map3 :: Either L a -> Either L b -> Either L c -> (a -> b -> c -> r) -> Either L r
+//
+// If every Either is Right, it returns a Right with the result of fn. Otherwise, it returns the first Left in argument order.
+func Map3[L, T1, T2, T3, U any](e1 Either[L, T1], e2 Either[L, T2], e3 Either[L, T3], fn func(T1, T2, T3) U) Either[L, U] {
+	switch {
+	case e1.isLeft:
+		return Left[U](e1.left)
+	case e2.isLeft:
+		return Left[U](e2.left)
+	case e3.isLeft:
+		return Left[U](e3.left)
+	}
+	return Right[L](fn(e1.right, e2.right, e3.right))
+}
+
+// Map4 combines 4 Eithers with a function of 4 arguments, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map4 :: Either L a -> Either L b -> Either L c -> Either L d -> (a -> b -> c -> d -> r) -> Either L r
+//
+// If every Either is Right, it returns a Right with the result of fn. Otherwise, it returns the first Left in argument order.
+func Map4[L, T1, T2, T3, T4, U any](e1 Either[L, T1], e2 Either[L, T2], e3 Either[L, T3], e4 Either[L, T4], fn func(T1, T2, T3, T4) U) Either[L, U] {
+	switch {
+	case e1.isLeft:
+		return Left[U](e1.left)
+	case e2.isLeft:
+		return Left[U](e2.left)
+	case e3.isLeft:
+		return Left[U](e3.left)
+	case e4.isLeft:
+		return Left[U](e4.left)
+	}
+	return Right[L](fn(e1.right, e2.right, e3.right, e4.right))
+}
+
+// Map5 combines 5 Eithers with a function of 5 arguments, treating Either as right-biased.
+//
+// Type signature:
+//
+//	Map5 :: Either L a -> Either L b -> Either L c -> Either L d -> Either L e -> (a -> b -> c -> d -> e -> r) -> Either L r
+//
+// If every Either is Right, it returns a Right with the result of fn. Otherwise, it returns the first Left in argument order.
+func Map5[L, T1, T2, T3, T4, T5, U any](e1 Either[L, T1], e2 Either[L, T2], e3 Either[L, T3], e4 Either[L, T4], e5 Either[L, T5], fn func(T1, T2, T3, T4, T5) U) Either[L, U] {
+	switch {
+	case e1.isLeft:
+		return Left[U](e1.left)
+	case e2.isLeft:
+		return Left[U](e2.left)
+	case e3.isLeft:
+		return Left[U](e3.left)
+	case e4.isLeft:
+		return Left[U](e4.left)
+	case e5.isLeft:
+		return Left[U](e5.left)
+	}
+	return Right[L](fn(e1.right, e2.right, e3.right, e4.right, e5.right))
+}
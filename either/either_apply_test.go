@@ -0,0 +1,43 @@
+package either_test
+
+import (
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestApply(t *testing.T) {
+	double := either.Right[string](func(x int) int { return x * 2 })
+
+	if got := either.Apply(double, either.Right[string](21)); !either.Equal(got, either.Right[string](42)) {
+		t.Errorf("expected Right(42), got %v", got)
+	}
+	got := either.Apply(either.Left[func(int) int]("fn"), either.Left[int]("value"))
+	if !either.Equal(got, either.Left[int]("fn")) {
+		t.Errorf("expected the first Left, got %v", got)
+	}
+	if got := either.Apply(double, either.Left[int]("value")); !either.Equal(got, either.Left[int]("value")) {
+		t.Errorf("expected Left(value), got %v", got)
+	}
+}
+
+func TestMapN(t *testing.T) {
+	one := either.Right[string](1)
+	got := []either.Either[string, int]{
+		either.Map2(one, one, func(a, b int) int { return a + b }),
+		either.Map3(one, one, one, func(a, b, c int) int { return a + b + c }),
+		either.Map4(one, one, one, one, func(a, b, c, d int) int { return a + b + c + d }),
+		either.Map5(one, one, one, one, one, func(a, b, c, d, e int) int { return a + b + c + d + e }),
+	}
+	for i, e := range got {
+		if want := either.Right[string](i + 2); !either.Equal(e, want) {
+			t.Errorf("Map%d: expected %v, got %v", i+2, want, e)
+		}
+	}
+
+	r := either.Map4(one, either.Left[int]("second"), one, either.Left[int]("fourth"),
+		func(a, b, c, d int) int { return a + b + c + d })
+	if !either.Equal(r, either.Left[int]("second")) {
+		t.Errorf("expected the first Left, got %v", r)
+	}
+}
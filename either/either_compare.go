@@ -0,0 +1,66 @@
+package either
+
+import "cmp"
+
+// Equal reports whether two Either values of comparable types are equal, without reflection.
+//
+// Type signature:
+//
+//	Equal :: Either L R -> Either L R -> Bool
+//
+// Two Eithers are equal if both are Left or both are Right, with values that are equal under ==.
+// Eithers of comparable types are themselves comparable, so they can also be used as map keys.
+func Equal[L, R comparable](a, b Either[L, R]) bool {
+	return a.isLeft == b.isLeft && (a.isLeft && a.left == b.left || !a.isLeft && a.right == b.right)
+}
+
+// EqualFunc reports whether two Either values are equal, using eqL and eqR to compare their values.
+//
+// Type signature:
+//
+//	EqualFunc :: Either L R -> Either L R -> ((L, L) -> Bool) -> ((R, R) -> Bool) -> Bool
+//
+// Two Eithers are equal if both are Left and eqL returns true for their left values,
+// or both are Right and eqR returns true for their right values.
+func EqualFunc[L, R any](a, b Either[L, R], eqL func(L, L) bool, eqR func(R, R) bool) bool {
+	if a.isLeft != b.isLeft {
+		return false
+	}
+	if a.isLeft {
+		return eqL(a.left, b.left)
+	}
+	return eqR(a.right, b.right)
+}
+
+// Compare compares two Either values of ordered types.
+//
+// Type signature:
+//
+//	Compare :: Either L R -> Either L R -> Int
+//
+// Left sorts before any Right, and two values on the same side are ordered by cmp.Compare.
+// It returns -1 if a is less than b, 0 if they are equal and +1 if a is greater than b,
+// so it can be passed to slices.SortFunc.
+func Compare[L, R cmp.Ordered](a, b Either[L, R]) int {
+	return CompareFunc(a, b, cmp.Compare[L], cmp.Compare[R])
+}
+
+// CompareFunc compares two Either values, using compareL and compareR to order their values.
+//
+// Type signature:
+//
+//	CompareFunc :: Either L R -> Either L R -> ((L, L) -> Int) -> ((R, R) -> Int) -> Int
+//
+// Left sorts before any Right, and two values on the same side are ordered by the matching function.
+func CompareFunc[L, R any](a, b Either[L, R], compareL func(L, L) int, compareR func(R, R) int) int {
+	switch {
+	case a.isLeft && b.isLeft:
+		return compareL(a.left, b.left)
+	case a.isLeft:
+		return -1
+	case b.isLeft:
+		return 1
+	default:
+		return compareR(a.right, b.right)
+	}
+}
@@ -0,0 +1,66 @@
+package either_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestEqual(t *testing.T) {
+	if !either.Equal(either.Left[string](1), either.Left[string](1)) {
+		t.Errorf("expected equal Left values to be equal")
+	}
+	if either.Equal(either.Left[string](1), either.Left[string](2)) {
+		t.Errorf("expected different Left values to be unequal")
+	}
+	if !either.Equal(either.Right[int]("a"), either.Right[int]("a")) {
+		t.Errorf("expected equal Right values to be equal")
+	}
+	if either.Equal(either.Left[string](0), either.Right[int]("")) {
+		t.Errorf("expected Left and Right to be unequal")
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	e := either.EqualFunc(
+		either.Right[int]("A"),
+		either.Right[int]("a"),
+		func(a, b int) bool { return a == b },
+		strings.EqualFold,
+	)
+	if !e {
+		t.Errorf("expected Right values to be equal under EqualFold")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	es := []either.Either[int, string]{
+		either.Right[int]("b"),
+		either.Left[string](2),
+		either.Right[int]("a"),
+		either.Left[string](1),
+	}
+	slices.SortFunc(es, either.Compare[int, string])
+	want := []either.Either[int, string]{
+		either.Left[string](1),
+		either.Left[string](2),
+		either.Right[int]("a"),
+		either.Right[int]("b"),
+	}
+	if !slices.Equal(es, want) {
+		t.Errorf("expected %v, got %v", want, es)
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	byVal := func(a, b int) int { return a - b }
+	if either.CompareFunc(either.Right[int]("aa"), either.Right[int]("b"), byVal, byLen) <= 0 {
+		t.Errorf("expected longer string to sort after shorter string")
+	}
+	if either.CompareFunc(either.Right[int](""), either.Left[string](9), byVal, byLen) != 1 {
+		t.Errorf("expected Right to sort after Left")
+	}
+}
@@ -0,0 +1,36 @@
+package either
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String returns the Either rendered as Left(value) or Right(value).
+//
+// Type signature:
+//
+//	String :: Either L R -> String
+func (e Either[L, R]) String() string {
+	return fmt.Sprint(e)
+}
+
+// Format implements fmt.Formatter.
+//
+// The Either is rendered as Left(value) or Right(value), with the verb and flags applied to the value.
+// %#v renders a Go-syntax constructor expression, such as either.Left[string, int](3).
+func (e Either[L, R]) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('#') && e.isLeft:
+		fmt.Fprintf(s, "either.Left[%s, %s](%#v)", typeName[R](), typeName[L](), e.left)
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "either.Right[%s, %s](%#v)", typeName[L](), typeName[R](), e.right)
+	case e.isLeft:
+		fmt.Fprintf(s, "Left("+fmt.FormatString(s, verb)+")", e.left)
+	default:
+		fmt.Fprintf(s, "Right("+fmt.FormatString(s, verb)+")", e.right)
+	}
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
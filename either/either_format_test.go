@@ -0,0 +1,33 @@
+package either_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		e      any
+		want   string
+	}{
+		{"%v", either.Left[string](3), "Left(3)"},
+		{"%v", either.Right[int]("x"), "Right(x)"},
+		{"%q", either.Right[int]("x"), `Right("x")`},
+		{"%#v", either.Left[string](3), "either.Left[string, int](3)"},
+		{"%#v", either.Right[int]("x"), `either.Right[int, string]("x")`},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.e); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := either.Left[string](42).String(); got != "Left(42)" {
+		t.Errorf("String() = %q, want %q", got, "Left(42)")
+	}
+}
@@ -0,0 +1,61 @@
+package either
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type taggedJSON struct {
+	Tag   string          `json:"tag"`
+	Value json.RawMessage `json:"value"`
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The Either is encoded as an object with a "tag" discriminator of "left" or "right",
+// and a "value" holding the encoded value of that side, such as {"tag":"left","value":42}.
+func (e Either[L, R]) MarshalJSON() ([]byte, error) {
+	var (
+		value []byte
+		err   error
+		tag   = "right"
+	)
+	if e.isLeft {
+		tag = "left"
+		value, err = json.Marshal(e.left)
+	} else {
+		value, err = json.Marshal(e.right)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(taggedJSON{Tag: tag, Value: value})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is neither "left" nor "right".
+// As with encoding/json, a JSON null leaves the Either unchanged.
+func (e *Either[L, R]) UnmarshalJSON(data []byte) error {
+	var tagged *taggedJSON
+	if err := json.Unmarshal(data, &tagged); err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "left":
+		var l L
+		if err := json.Unmarshal(tagged.Value, &l); err != nil {
+			return err
+		}
+		*e = Left[R](l)
+	case "right":
+		var r R
+		if err := json.Unmarshal(tagged.Value, &r); err != nil {
+			return err
+		}
+		*e = Right[L](r)
+	default:
+		return fmt.Errorf("either: unknown tag %q", tagged.Tag)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package either_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(either.Left[string](42))
+	if err != nil || string(data) != `{"tag":"left","value":42}` {
+		t.Errorf("unexpected encoding: %s, %v", data, err)
+	}
+	data, err = json.Marshal(either.Right[int]("x"))
+	if err != nil || string(data) != `{"tag":"right","value":"x"}` {
+		t.Errorf("unexpected encoding: %s, %v", data, err)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var e either.Either[int, string]
+	if err := json.Unmarshal([]byte(`{"tag":"left","value":42}`), &e); err != nil || !either.Equal(e, either.Left[string](42)) {
+		t.Errorf("unexpected decoding: %v, %v", e, err)
+	}
+	if err := json.Unmarshal([]byte(`{"tag":"right","value":"x"}`), &e); err != nil || !either.Equal(e, either.Right[int]("x")) {
+		t.Errorf("unexpected decoding: %v, %v", e, err)
+	}
+	if err := json.Unmarshal([]byte(`{"tag":"middle","value":1}`), &e); err == nil {
+		t.Errorf("expected error for unknown tag")
+	}
+	if err := json.Unmarshal([]byte(`{"tag":"left","value":"x"}`), &e); err == nil {
+		t.Errorf("expected error for mismatched value type")
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	e := either.Right[int]("kept")
+	if err := json.Unmarshal([]byte(`null`), &e); err != nil || !either.Equal(e, either.Right[int]("kept")) {
+		t.Errorf("Unmarshal(null) = %v, %v; want the value unchanged", e, err)
+	}
+	var fields struct{ E *either.Either[int, string] }
+	if err := json.Unmarshal([]byte(`{"E":null}`), &fields); err != nil || fields.E != nil {
+		t.Errorf("Unmarshal(null field) = %v, %v", fields.E, err)
+	}
+}
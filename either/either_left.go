@@ -74,3 +74,50 @@ func (l *Either[L, R]) LeftOrNil() *L {
 	}
 	return nil
 }
+
+// TapLeft runs a side effect on the Left value if it exists.
+//
+// Type signature:
+//
+//	TapLeft :: Either L R -> (L -> ()) -> Either L R
+//
+// It returns the Either unchanged, allowing the side effect to be inserted into a chain.
+func (e Either[L, R]) TapLeft(l func(L)) Either[L, R] {
+	if e.isLeft {
+		l(e.left)
+	}
+	return e
+}
+
+// LMap applies a function to the Left value if it exists.
+//
+// Type signature:
+//
+//	LMap :: Either L R -> (L -> L) -> Either L R
+//
+// If the Either is Left, it applies the l function to the left value and wraps the result in a new Either.
+// If the Either is Right, it returns the current Right value.
+func (e Either[L, R]) LMap(l func(L) L) Either[L, R] {
+	return LMap(e, l)
+}
+
+// LeftOrElse returns the Left value if it exists, or the result of the provided function otherwise.
+//
+// Type signature:
+//
+//	LeftOrElse :: Either L R -> (() -> L) -> L
+func LeftOrElse[L, R any](e Either[L, R], fn func() L) L {
+	if e.isLeft {
+		return e.left
+	}
+	return fn()
+}
+
+// LeftOrElse returns the Left value if it exists, or the result of the provided function otherwise.
+//
+// Type signature:
+//
+//	LeftOrElse :: Either L R -> (() -> L) -> L
+func (e Either[L, R]) LeftOrElse(fn func() L) L {
+	return LeftOrElse(e, fn)
+}
@@ -0,0 +1,18 @@
+package either
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer.
+//
+// Type signature:
+//
+//	LogValue :: Either L R -> slog.Value
+//
+// Left is logged as a group with a "left" attribute holding the left value, and Right is logged
+// as a group with a "right" attribute holding the right value.
+func (e Either[L, R]) LogValue() slog.Value {
+	if e.isLeft {
+		return slog.GroupValue(slog.Any("left", e.left))
+	}
+	return slog.GroupValue(slog.Any("right", e.right))
+}
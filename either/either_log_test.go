@@ -0,0 +1,24 @@
+package either_test
+
+import (
+	"log/slog"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestLogValueLeft(t *testing.T) {
+	v := either.Left[string](42).LogValue()
+	attrs := v.Group()
+	if v.Kind() != slog.KindGroup || len(attrs) != 1 || attrs[0].Key != "left" || attrs[0].Value.Int64() != 42 {
+		t.Errorf("unexpected log value: %v", v)
+	}
+}
+
+func TestLogValueRight(t *testing.T) {
+	v := either.Right[int]("42").LogValue()
+	attrs := v.Group()
+	if v.Kind() != slog.KindGroup || len(attrs) != 1 || attrs[0].Key != "right" || attrs[0].Value.String() != "42" {
+		t.Errorf("unexpected log value: %v", v)
+	}
+}
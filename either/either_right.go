@@ -74,3 +74,77 @@ func (r Either[L, R]) RightOrNil() *R {
 	}
 	return nil
 }
+
+// TapRight runs a side effect on the Right value if it exists.
+//
+// Type signature:
+//
+//	TapRight :: Either L R -> (R -> ()) -> Either L R
+//
+// It returns the Either unchanged, allowing the side effect to be inserted into a chain.
+func (e Either[L, R]) TapRight(r func(R)) Either[L, R] {
+	if !e.isLeft {
+		r(e.right)
+	}
+	return e
+}
+
+// RMap applies a function to the Right value if it exists.
+//
+// Type signature:
+//
+//	RMap :: Either L R -> (R -> R) -> Either L R
+//
+// If the Either is Right, it applies the r function to the right value and wraps the result in a new Either.
+// If the Either is Left, it returns the current Left value.
+func (e Either[L, R]) RMap(r func(R) R) Either[L, R] {
+	return RMap(e, r)
+}
+
+// RightOrElse returns the Right value if it exists, or the result of the provided function otherwise.
+//
+// Type signature:
+//
+//	RightOrElse :: Either L R -> (() -> R) -> R
+func RightOrElse[L, R any](e Either[L, R], fn func() R) R {
+	if !e.isLeft {
+		return e.right
+	}
+	return fn()
+}
+
+// RightOrElse returns the Right value if it exists, or the result of the provided function otherwise.
+//
+// Type signature:
+//
+//	RightOrElse :: Either L R -> (() -> R) -> R
+func (e Either[L, R]) RightOrElse(fn func() R) R {
+	return RightOrElse(e, fn)
+}
+
+// FilterOrElse keeps the Right value if it satisfies the predicate, replacing it with a Left otherwise.
+//
+// Type signature:
+//
+//	FilterOrElse :: Either L R -> (R -> Bool) -> L -> Either L R
+//
+// If the Either is Right and pred returns false for its value, it returns a Left holding the provided left value.
+// Otherwise, it returns the Either unchanged.
+func FilterOrElse[L, R any](e Either[L, R], pred func(R) bool, left L) Either[L, R] {
+	if !e.isLeft && !pred(e.right) {
+		return Left[R](left)
+	}
+	return e
+}
+
+// FilterOrElse keeps the Right value if it satisfies the predicate, replacing it with a Left otherwise.
+//
+// Type signature:
+//
+//	FilterOrElse :: Either L R -> (R -> Bool) -> L -> Either L R
+//
+// If the Either is Right and pred returns false for its value, it returns a Left holding the provided left value.
+// Otherwise, it returns the Either unchanged.
+func (e Either[L, R]) FilterOrElse(pred func(R) bool, left L) Either[L, R] {
+	return FilterOrElse(e, pred, left)
+}
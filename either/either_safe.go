@@ -0,0 +1,47 @@
+package either
+
+import "github.com/alsi-lawr/gonads/internal/panics"
+
+// PanicError is the error held in the Left of an Either produced from a recovered panic.
+// It carries the value passed to panic and the stack of the goroutine that panicked,
+// and can be detected with errors.As.
+//
+// It is the same type as result.PanicError.
+type PanicError = panics.Error
+
+// LiftSafe lifts a function that returns a value and an error into an Either, recovering from panics.
+//
+// Type signature:
+//
+//	LiftSafe :: (() -> (R, error)) -> Either error R
+//
+// If fn returns an error, it is returned as Left. If fn panics, the panic is recovered and returned
+// as a Left holding a *PanicError. Otherwise, the value is returned as Right.
+func LiftSafe[R any](fn func() (R, error)) (e Either[error, R]) {
+	defer func() {
+		if r := recover(); r != nil {
+			e = Left[R, error](panics.New(r))
+		}
+	}()
+	val, err := fn()
+	if err != nil {
+		return Left[R](err)
+	}
+	return Right[error](val)
+}
+
+// TryCatch runs a function that may panic, capturing its value in an Either.
+//
+// Type signature:
+//
+//	TryCatch :: (() -> R) -> Either error R
+//
+// It returns Right with the value returned by fn, or a Left holding a *PanicError if fn panics.
+func TryCatch[R any](fn func() R) (e Either[error, R]) {
+	defer func() {
+		if r := recover(); r != nil {
+			e = Left[R, error](panics.New(r))
+		}
+	}()
+	return Right[error](fn())
+}
@@ -0,0 +1,52 @@
+package either_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+)
+
+func TestLiftSafeRight(t *testing.T) {
+	e := either.LiftSafe(func() (int, error) { return 42, nil })
+	if !e.IsRight() || *e.RightOrNil() != 42 {
+		t.Errorf("expected Right result")
+	}
+}
+
+func TestLiftSafeLeft(t *testing.T) {
+	testErr := errors.New("test error")
+	e := either.LiftSafe(func() (int, error) { return 0, testErr })
+	if !e.IsLeft() || *e.LeftOrNil() != testErr {
+		t.Errorf("expected Left result")
+	}
+}
+
+func TestLiftSafePanic(t *testing.T) {
+	e := either.LiftSafe(func() (int, error) { panic("boom") })
+	e.Match(func(err error) {
+		var panicErr *either.PanicError
+		if !errors.As(err, &panicErr) || panicErr.Value != "boom" {
+			t.Errorf("expected panic error, got %v", err)
+		}
+		if len(panicErr.Stack) == 0 {
+			t.Errorf("expected stack to be captured")
+		}
+	}, func(r int) {
+		t.Errorf("expected Left result")
+	})
+}
+
+func TestTryCatchRight(t *testing.T) {
+	e := either.TryCatch(func() string { return "42" })
+	if !e.IsRight() || *e.RightOrNil() != "42" {
+		t.Errorf("expected Right result")
+	}
+}
+
+func TestTryCatchPanic(t *testing.T) {
+	e := either.TryCatch(func() string { panic("boom") })
+	if !e.IsLeft() {
+		t.Errorf("expected Left result")
+	}
+}
@@ -329,3 +329,140 @@ func TestRightOrNilNil(t *testing.T) {
 		t.Errorf("expected nil result")
 	}
 }
+
+func TestTapLeft(t *testing.T) {
+	var seen int
+	e := either.Left[string](42).
+		TapLeft(func(l int) { seen = l }).
+		TapRight(func(r string) { t.Errorf("expected TapRight not to run on Left") })
+	if seen != 42 || !e.IsLeft() {
+		t.Errorf("expected TapLeft to see 42 and return the Either unchanged")
+	}
+}
+
+func TestTapRight(t *testing.T) {
+	var seen string
+	e := either.Right[int]("42").
+		TapRight(func(r string) { seen = r }).
+		TapLeft(func(l int) { t.Errorf("expected TapLeft not to run on Right") })
+	if seen != "42" || !e.IsRight() {
+		t.Errorf("expected TapRight to see \"42\" and return the Either unchanged")
+	}
+}
+
+func TestInspect(t *testing.T) {
+	called := false
+	either.Right[int]("42").Inspect(func(e either.Either[int, string]) {
+		called = e.IsRight()
+	})
+	if !called {
+		t.Errorf("expected Inspect to see Right")
+	}
+}
+
+func TestSwap(t *testing.T) {
+	e := either.Left[string](42).Swap()
+	if !e.IsRight() || *e.RightOrNil() != 42 {
+		t.Errorf("expected Left to swap to Right")
+	}
+	s := either.Swap(either.Right[int]("42"))
+	if !s.IsLeft() || *s.LeftOrNil() != "42" {
+		t.Errorf("expected Right to swap to Left")
+	}
+}
+
+func TestFold(t *testing.T) {
+	got := either.Fold(either.Left[string](42), strconv.Itoa, func(r string) string { return r + "!" })
+	if got != "42" {
+		t.Errorf("expected Fold on Left to be \"42\", got %q", got)
+	}
+	got = either.Right[int]("42").Fold(strconv.Itoa, func(r string) string { return r + "!" })
+	if got != "42!" {
+		t.Errorf("expected Fold on Right to be \"42!\", got %q", got)
+	}
+}
+
+func TestRightBiasedMapBind(t *testing.T) {
+	parse := func(s string) either.Either[error, int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return either.Left[int](err)
+		}
+		return either.Right[error](n)
+	}
+	e := either.Bind(either.Right[error]("21"), parse).
+		Map(func(n int) int { return n * 2 }).
+		Bind(func(n int) either.Either[error, int] { return either.Right[error](n + 1) })
+	if !either.Equal(either.Map(e, strconv.Itoa), either.Right[error]("43")) {
+		t.Errorf("expected Right(\"43\"), got %v", e)
+	}
+	if either.Bind(either.Right[error]("x"), parse).Map(func(n int) int { return n * 2 }).IsRight() {
+		t.Errorf("expected Left after failed parse")
+	}
+}
+
+func TestLMapRMapMethods(t *testing.T) {
+	l := either.Left[string](1).LMap(func(l int) int { return l + 1 }).RMap(func(r string) string { return r + "!" })
+	if *l.LeftOrNil() != 2 {
+		t.Errorf("expected Left(2), got %v", l)
+	}
+	r := either.Right[int]("a").LMap(func(l int) int { return l + 1 }).RMap(func(r string) string { return r + "!" })
+	if *r.RightOrNil() != "a!" {
+		t.Errorf("expected Right(\"a!\"), got %v", r)
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	l := either.Left[string](42)
+	r := either.Right[int]("42")
+	if l.LeftOrElse(func() int { return 0 }) != 42 || either.LeftOrElse(r, func() int { return 0 }) != 0 {
+		t.Errorf("unexpected LeftOrElse result")
+	}
+	if r.RightOrElse(func() string { return "" }) != "42" || either.RightOrElse(l, func() string { return "none" }) != "none" {
+		t.Errorf("unexpected RightOrElse result")
+	}
+}
+
+func TestFilterOrElse(t *testing.T) {
+	positive := func(n int) bool { return n > 0 }
+	if e := either.Right[string](1).FilterOrElse(positive, "not positive"); !e.IsRight() {
+		t.Errorf("expected Right to pass the filter")
+	}
+	e := either.FilterOrElse(either.Right[string](-1), positive, "not positive")
+	if !e.IsLeft() || *e.LeftOrNil() != "not positive" {
+		t.Errorf("expected Left(\"not positive\"), got %v", e)
+	}
+	e = either.Left[int]("already left").FilterOrElse(positive, "not positive")
+	if *e.LeftOrNil() != "already left" {
+		t.Errorf("expected original Left to be kept, got %v", e)
+	}
+}
+
+func TestSequence(t *testing.T) {
+	all := []either.Either[string, int]{either.Right[string](1), either.Right[string](2)}
+	s := either.Sequence(all)
+	if !s.IsRight() || len(*s.RightOrNil()) != 2 || (*s.RightOrNil())[1] != 2 {
+		t.Errorf("expected Right([1 2]), got %v", s)
+	}
+	mixed := []either.Either[string, int]{either.Right[string](1), either.Left[int]("a"), either.Left[int]("b")}
+	s = either.Sequence(mixed)
+	if !s.IsLeft() || *s.LeftOrNil() != "a" {
+		t.Errorf("expected first Left, got %v", s)
+	}
+}
+
+func TestTraverse(t *testing.T) {
+	parse := func(s string) either.Either[error, int] {
+		n, err := strconv.Atoi(s)
+		if err != nil {
+			return either.Left[int](err)
+		}
+		return either.Right[error](n)
+	}
+	if e := either.Traverse([]string{"1", "2", "3"}, parse); !e.IsRight() || len(*e.RightOrNil()) != 3 {
+		t.Errorf("expected Right with three values, got %v", e)
+	}
+	if e := either.Traverse([]string{"1", "x"}, parse); !e.IsLeft() {
+		t.Errorf("expected Left, got %v", e)
+	}
+}
@@ -0,0 +1,97 @@
+package fn
+
+// Curry converts a function of two arguments into a chain of functions of one argument.
+//
+// Type signature:
+//
+//	Curry :: ((a, b) -> c) -> (a -> b -> c)
+func Curry[A, B, C any](f func(A, B) C) func(A) func(B) C {
+	return func(a A) func(B) C {
+		return func(b B) C { return f(a, b) }
+	}
+}
+
+// Curry3 converts a function of three arguments into a chain of functions of one argument.
+//
+// Type signature:
+//
+//	Curry3 :: ((a, b, c) -> d) -> (a -> b -> c -> d)
+func Curry3[A, B, C, D any](f func(A, B, C) D) func(A) func(B) func(C) D {
+	return func(a A) func(B) func(C) D {
+		return func(b B) func(C) D {
+			return func(c C) D { return f(a, b, c) }
+		}
+	}
+}
+
+// Uncurry converts a chain of functions of one argument into a function of two arguments.
+//
+// Type signature:
+//
+//	Uncurry :: (a -> b -> c) -> ((a, b) -> c)
+func Uncurry[A, B, C any](f func(A) func(B) C) func(A, B) C {
+	return func(a A, b B) C { return f(a)(b) }
+}
+
+// Uncurry3 converts a chain of functions of one argument into a function of three arguments.
+//
+// Type signature:
+//
+//	Uncurry3 :: (a -> b -> c -> d) -> ((a, b, c) -> d)
+func Uncurry3[A, B, C, D any](f func(A) func(B) func(C) D) func(A, B, C) D {
+	return func(a A, b B, c C) D { return f(a)(b)(c) }
+}
+
+// Partial fixes the first argument of a function of two arguments.
+//
+// Type signature:
+//
+//	Partial :: ((a, b) -> c) -> a -> (b -> c)
+func Partial[A, B, C any](f func(A, B) C, a A) func(B) C {
+	return func(b B) C { return f(a, b) }
+}
+
+// PartialRight fixes the last argument of a function of two arguments.
+//
+// Type signature:
+//
+//	PartialRight :: ((a, b) -> c) -> b -> (a -> c)
+func PartialRight[A, B, C any](f func(A, B) C, b B) func(A) C {
+	return func(a A) C { return f(a, b) }
+}
+
+// Partial3 fixes the first argument of a function of three arguments.
+//
+// Type signature:
+//
+//	Partial3 :: ((a, b, c) -> d) -> a -> ((b, c) -> d)
+func Partial3[A, B, C, D any](f func(A, B, C) D, a A) func(B, C) D {
+	return func(b B, c C) D { return f(a, b, c) }
+}
+
+// Flip swaps the arguments of a function of two arguments.
+//
+// Type signature:
+//
+//	Flip :: ((a, b) -> c) -> ((b, a) -> c)
+func Flip[A, B, C any](f func(A, B) C) func(B, A) C {
+	return func(b B, a A) C { return f(a, b) }
+}
+
+// Const returns a function that ignores its argument and always returns val.
+//
+// Type signature:
+//
+//	Const :: b -> (a -> b)
+func Const[A, B any](val B) func(A) B {
+	return func(A) B { return val }
+}
+
+// Identity returns its argument unchanged.
+//
+// Type signature:
+//
+//	Identity :: a -> a
+func Identity[T any](val T) T {
+	return val
+}
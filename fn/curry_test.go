@@ -0,0 +1,54 @@
+package fn_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/fn"
+)
+
+func sub(a, b int) int { return a - b }
+
+func TestCurryUncurry(t *testing.T) {
+	if got := fn.Curry(sub)(5)(3); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+	if got := fn.Uncurry(fn.Curry(sub))(5, 3); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+
+	join := func(a, b, c string) string { return a + b + c }
+	if got := fn.Curry3(join)("a")("b")("c"); got != "abc" {
+		t.Errorf("expected \"abc\", got %q", got)
+	}
+	if got := fn.Uncurry3(fn.Curry3(join))("a", "b", "c"); got != "abc" {
+		t.Errorf("expected \"abc\", got %q", got)
+	}
+}
+
+func TestPartial(t *testing.T) {
+	if got := fn.Partial(sub, 10)(4); got != 6 {
+		t.Errorf("expected 6, got %d", got)
+	}
+	if got := fn.PartialRight(sub, 10)(4); got != -6 {
+		t.Errorf("expected -6, got %d", got)
+	}
+	if got := fn.Partial3(strings.ReplaceAll, "a-b-c")("-", "+"); got != "a+b+c" {
+		t.Errorf("expected \"a+b+c\", got %q", got)
+	}
+}
+
+func TestFlip(t *testing.T) {
+	if got := fn.Flip(sub)(5, 3); got != -2 {
+		t.Errorf("expected -2, got %d", got)
+	}
+}
+
+func TestConstIdentity(t *testing.T) {
+	if got := fn.Const[string](7)("ignored"); got != 7 {
+		t.Errorf("expected 7, got %d", got)
+	}
+	if got := fn.Identity("same"); got != "same" {
+		t.Errorf("expected \"same\", got %q", got)
+	}
+}
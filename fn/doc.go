@@ -0,0 +1,22 @@
+/*
+Package fn provides helpers for building the functions passed to the monads, such as composition,
+currying, partial application and memoisation.
+
+Every helper returns a plain Go func, so the results plug straight into option.Map, result.Bind, iters.Map
+and the rest of the library.
+
+fn consists of:
+
+	Pipe2..Pipe9/Compose: Compose functions from left to right, or from right to left.
+	Curry/Uncurry/Partial: Convert between multi-argument and single-argument functions.
+	Flip/Const/Identity: Small combinators for adapting function shapes.
+	Memoize/MemoizeLRU: Cache the results of a function, optionally bounded.
+
+Usage Example:
+
+	normalise := fn.Pipe3(strings.TrimSpace, strings.ToLower, fn.PartialRight(strings.TrimPrefix, "@"))
+	handles := iters.Map(names, normalise)
+
+In this example, Pipe3 builds a single func(string) string from three steps, ready to pass to iters.Map.
+*/
+package fn
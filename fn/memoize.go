@@ -0,0 +1,85 @@
+package fn
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Memoize caches the results of a function, keyed by key(arg).
+//
+// Type signature:
+//
+//	Memoize :: (a -> b) -> (a -> k) -> (a -> b)
+//
+// The returned function is safe for concurrent use, and the cache grows without bound.
+// For comparable arguments, Identity can be used as the key function.
+//
+// f is called without holding the cache lock, so a memoised function may call itself recursively.
+// Concurrent calls with the same key may therefore each run f once before the result is cached.
+func Memoize[A any, K comparable, B any](f func(A) B, key func(A) K) func(A) B {
+	var mu sync.Mutex
+	cache := make(map[K]B)
+	return func(a A) B {
+		k := key(a)
+		mu.Lock()
+		val, ok := cache[k]
+		mu.Unlock()
+		if ok {
+			return val
+		}
+
+		val = f(a)
+		mu.Lock()
+		cache[k] = val
+		mu.Unlock()
+		return val
+	}
+}
+
+// MemoizeLRU caches the results of a function, keyed by key(arg), keeping at most capacity entries.
+//
+// Type signature:
+//
+//	MemoizeLRU :: (a -> b) -> (a -> k) -> Int -> (a -> b)
+//
+// When the cache is full, the least recently used entry is evicted. A capacity less than one disables caching.
+// Like Memoize, the returned function is safe for concurrent use and may be called recursively.
+func MemoizeLRU[A any, K comparable, B any](f func(A) B, key func(A) K, capacity int) func(A) B {
+	type entry struct {
+		key K
+		val B
+	}
+	var mu sync.Mutex
+	order := list.New()
+	cache := make(map[K]*list.Element)
+	return func(a A) B {
+		k := key(a)
+		mu.Lock()
+		if el, ok := cache[k]; ok {
+			order.MoveToFront(el)
+			val := el.Value.(entry).val
+			mu.Unlock()
+			return val
+		}
+		mu.Unlock()
+
+		val := f(a)
+		if capacity < 1 {
+			return val
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if el, ok := cache[k]; ok {
+			el.Value = entry{key: k, val: val}
+			order.MoveToFront(el)
+			return val
+		}
+		cache[k] = order.PushFront(entry{key: k, val: val})
+		if order.Len() > capacity {
+			oldest := order.Back()
+			order.Remove(oldest)
+			delete(cache, oldest.Value.(entry).key)
+		}
+		return val
+	}
+}
@@ -0,0 +1,125 @@
+package fn_test
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/fn"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestMemoize(t *testing.T) {
+	calls := 0
+	square := fn.Memoize(func(x int) int {
+		calls++
+		return x * x
+	}, fn.Identity[int])
+
+	for i := 0; i < 3; i++ {
+		if got := square(4); got != 16 {
+			t.Errorf("expected 16, got %d", got)
+		}
+	}
+	square(5)
+	if calls != 2 {
+		t.Errorf("expected 2 calls, got %d", calls)
+	}
+}
+
+func TestMemoizeKeyFunc(t *testing.T) {
+	calls := 0
+	lookup := fn.Memoize(func(s string) int {
+		calls++
+		return len(s)
+	}, strings.ToLower)
+	lookup("Hello")
+	lookup("HELLO")
+	if calls != 1 {
+		t.Errorf("expected keys to be normalised, got %d calls", calls)
+	}
+}
+
+func TestMemoizeRecursive(t *testing.T) {
+	var fib func(int) int
+	fib = fn.Memoize(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}, fn.Identity[int])
+	if got := fib(80); got != 23416728348467685 {
+		t.Errorf("expected fib(80), got %d", got)
+	}
+}
+
+func TestMemoizeConcurrent(t *testing.T) {
+	double := fn.Memoize(func(x int) int { return x * 2 }, fn.Identity[int])
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if got := double(i % 5); got != (i%5)*2 {
+				t.Errorf("expected %d, got %d", (i%5)*2, got)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestMemoizeLRU(t *testing.T) {
+	var calls []int
+	f := fn.MemoizeLRU(func(x int) int {
+		calls = append(calls, x)
+		return x
+	}, fn.Identity[int], 2)
+
+	f(1)
+	f(2)
+	f(1) // cached; 2 is now least recently used
+	f(3) // evicts 2
+	f(1) // cached
+	f(2) // recomputed
+
+	want := []int{1, 2, 3, 2}
+	if len(calls) != len(want) {
+		t.Fatalf("expected calls %v, got %v", want, calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Fatalf("expected calls %v, got %v", want, calls)
+		}
+	}
+}
+
+func TestMemoizeLRUDisabled(t *testing.T) {
+	calls := 0
+	f := fn.MemoizeLRU(func(x int) int {
+		calls++
+		return x
+	}, fn.Identity[int], 0)
+	f(1)
+	f(1)
+	if calls != 2 {
+		t.Errorf("expected caching to be disabled, got %d calls", calls)
+	}
+}
+
+func TestMemoizeWithResultBind(t *testing.T) {
+	calls := 0
+	check := fn.Memoize(func(x int) result.Result[int] {
+		calls++
+		if x < 0 {
+			return result.Err[int](errors.New("negative"))
+		}
+		return result.Ok(x)
+	}, fn.Identity[int])
+
+	result.Bind(result.Ok(3), check)
+	r := result.Bind(result.Ok(3), check)
+	if !result.Equal(r, result.Ok(3)) || calls != 1 {
+		t.Errorf("expected a cached Ok(3), got %v after %d calls", r, calls)
+	}
+}
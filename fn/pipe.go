@@ -0,0 +1,100 @@
+package fn
+
+// Compose composes two functions from right to left, as in mathematics.
+//
+// Type signature:
+//
+//	Compose :: (b -> c) -> (a -> b) -> (a -> c)
+//
+// The returned function applies f, then g. It is Pipe2 with its arguments reversed.
+func Compose[A, B, C any](g func(B) C, f func(A) B) func(A) C {
+	return func(a A) C { return g(f(a)) }
+}
+
+// Pipe2 composes 2 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe2 :: (a -> b) -> (b -> c) -> (a -> c)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe2[A, B, C any](f1 func(A) B, f2 func(B) C) func(A) C {
+	return func(a A) C { return f2(f1(a)) }
+}
+
+// Pipe3 composes 3 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe3 :: (a -> b) -> (b -> c) -> (c -> d) -> (a -> d)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe3[A, B, C, D any](f1 func(A) B, f2 func(B) C, f3 func(C) D) func(A) D {
+	return func(a A) D { return f3(f2(f1(a))) }
+}
+
+// Pipe4 composes 4 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe4 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (a -> e)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe4[A, B, C, D, E any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E) func(A) E {
+	return func(a A) E { return f4(f3(f2(f1(a)))) }
+}
+
+// Pipe5 composes 5 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe5 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (e -> f) -> (a -> f)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe5[A, B, C, D, E, F any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F) func(A) F {
+	return func(a A) F { return f5(f4(f3(f2(f1(a))))) }
+}
+
+// Pipe6 composes 6 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe6 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (e -> f) -> (f -> g) -> (a -> g)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe6[A, B, C, D, E, F, G any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G) func(A) G {
+	return func(a A) G { return f6(f5(f4(f3(f2(f1(a)))))) }
+}
+
+// Pipe7 composes 7 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe7 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (e -> f) -> (f -> g) -> (g -> h) -> (a -> h)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe7[A, B, C, D, E, F, G, H any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H) func(A) H {
+	return func(a A) H { return f7(f6(f5(f4(f3(f2(f1(a))))))) }
+}
+
+// Pipe8 composes 8 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe8 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (e -> f) -> (f -> g) -> (g -> h) -> (h -> i) -> (a -> i)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe8[A, B, C, D, E, F, G, H, I any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I) func(A) I {
+	return func(a A) I { return f8(f7(f6(f5(f4(f3(f2(f1(a)))))))) }
+}
+
+// Pipe9 composes 9 functions from left to right.
+//
+// Type signature:
+//
+//	Pipe9 :: (a -> b) -> (b -> c) -> (c -> d) -> (d -> e) -> (e -> f) -> (f -> g) -> (g -> h) -> (h -> i) -> (i -> j) -> (a -> j)
+//
+// The returned function applies f1 first and passes each result on to the next function.
+func Pipe9[A, B, C, D, E, F, G, H, I, J any](f1 func(A) B, f2 func(B) C, f3 func(C) D, f4 func(D) E, f5 func(E) F, f6 func(F) G, f7 func(G) H, f8 func(H) I, f9 func(I) J) func(A) J {
+	return func(a A) J { return f9(f8(f7(f6(f5(f4(f3(f2(f1(a))))))))) }
+}
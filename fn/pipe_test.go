@@ -0,0 +1,58 @@
+package fn_test
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/fn"
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func inc(x int) int { return x + 1 }
+
+func TestCompose(t *testing.T) {
+	f := fn.Compose(strconv.Itoa, inc)
+	if got := f(41); got != "42" {
+		t.Errorf("expected \"42\", got %q", got)
+	}
+}
+
+func TestPipeN(t *testing.T) {
+	got := []int{
+		fn.Pipe2(inc, inc)(0),
+		fn.Pipe3(inc, inc, inc)(0),
+		fn.Pipe4(inc, inc, inc, inc)(0),
+		fn.Pipe5(inc, inc, inc, inc, inc)(0),
+		fn.Pipe6(inc, inc, inc, inc, inc, inc)(0),
+		fn.Pipe7(inc, inc, inc, inc, inc, inc, inc)(0),
+		fn.Pipe8(inc, inc, inc, inc, inc, inc, inc, inc)(0),
+		fn.Pipe9(inc, inc, inc, inc, inc, inc, inc, inc, inc)(0),
+	}
+	for i, v := range got {
+		if v != i+2 {
+			t.Errorf("Pipe%d: expected %d, got %d", i+2, i+2, v)
+		}
+	}
+}
+
+func TestPipeOrder(t *testing.T) {
+	f := fn.Pipe3(strings.TrimSpace, strings.ToUpper, func(s string) int { return len(s) })
+	if got := f("  abc "); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+}
+
+func TestPipeWithMonads(t *testing.T) {
+	normalise := fn.Pipe3(strings.TrimSpace, strings.ToLower, fn.PartialRight(strings.TrimPrefix, "@"))
+
+	got := iters.Map([]string{" @Ada", "Bob "}, normalise)
+	if want := (iters.Iter[string]{"ada", "bob"}); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if opt := option.Map(option.Some(" @Cy"), normalise); !option.Equal(opt, option.Some("cy")) {
+		t.Errorf("expected Some(cy), got %v", opt)
+	}
+}
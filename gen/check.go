@@ -0,0 +1,128 @@
+package gen
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+// Config controls how Check runs a property. A nil *Config uses the defaults.
+type Config struct {
+	// Count is the number of values to test. Zero means 100.
+	Count int
+	// Seed seeds the random source. Zero means a seed taken from the current time, which is reported on failure.
+	Seed int64
+	// MaxShrinks bounds the number of shrinking steps taken after a failure. Zero means 1000.
+	MaxShrinks int
+}
+
+// Check runs prop against values generated by g, and reports the first failure through t.
+//
+// Type signature:
+//
+//	Check :: TB -> Gen a -> (a -> Bool) -> Config -> ()
+//
+// A property fails when it returns false or panics. The failing value is then shrunk to the smallest value that
+// still fails, and both values are reported together with the seed, so the failure can be reproduced with Config.Seed.
+func Check[T any](t testing.TB, g Gen[T], prop func(T) bool, cfg *Config) {
+	t.Helper()
+	var c Config
+	if cfg != nil {
+		c = *cfg
+	}
+	if c.Count <= 0 {
+		c.Count = 100
+	}
+	if c.Seed == 0 {
+		c.Seed = time.Now().UnixNano()
+	}
+	if c.MaxShrinks <= 0 {
+		c.MaxShrinks = 1000
+	}
+
+	r := rand.New(rand.NewSource(c.Seed))
+	for i := 1; i <= c.Count; i++ {
+		tree := g(r)
+		if holds(prop, tree.Value) {
+			continue
+		}
+		shrunk, steps := shrink(tree, prop, c.MaxShrinks)
+		t.Errorf("gen: property failed after %d tests and %d shrinks (seed %d)\n    original: %#v\n    shrunk:   %#v",
+			i, steps, c.Seed, tree.Value, shrunk)
+		return
+	}
+}
+
+// holds reports whether prop holds for val, treating a panic as a failure.
+func holds[T any](prop func(T) bool, val T) (ok bool) {
+	defer func() {
+		if recover() != nil {
+			ok = false
+		}
+	}()
+	return prop(val)
+}
+
+// shrink greedily follows the first shrink that still fails until none do, or maxSteps is reached.
+func shrink[T any](tree Tree[T], prop func(T) bool, maxSteps int) (T, int) {
+	steps := 0
+outer:
+	for steps < maxSteps {
+		for _, s := range tree.Shrinks() {
+			if !holds(prop, s.Value) {
+				tree = s
+				steps++
+				continue outer
+			}
+		}
+		break
+	}
+	return tree.Value, steps
+}
+
+// Fuzz runs prop as a native Go fuzz test, decoding each fuzz input into a value with g.
+//
+// Type signature:
+//
+//	Fuzz :: F -> Gen a -> (T -> a -> ()) -> ()
+//
+// The empty input is added to the seed corpus. It decodes to the smallest value g can generate, and as the
+// fuzzer minimises a failing input toward fewer and smaller bytes, the decoded value shrinks toward it too.
+func Fuzz[T any](f *testing.F, g Gen[T], prop func(t *testing.T, val T)) {
+	f.Helper()
+	f.Add([]byte{})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		prop(t, Decode(g, data))
+	})
+}
+
+// Decode generates a value with g, using data in place of a random source.
+//
+// Type signature:
+//
+//	Decode :: Gen a -> [Byte] -> a
+//
+// The same data always decodes to the same value. Once data is exhausted every random draw is zero,
+// so an empty input decodes to the smallest value g can generate.
+func Decode[T any](g Gen[T], data []byte) T {
+	return g.Sample(rand.New(&byteSource{data: data}))
+}
+
+// byteSource is a rand.Source that reads its values from a byte slice, then returns zeros.
+type byteSource struct {
+	data []byte
+}
+
+func (s *byteSource) Uint64() uint64 {
+	var buf [8]byte
+	n := copy(buf[:], s.data)
+	s.data = s.data[n:]
+	return binary.LittleEndian.Uint64(buf[:])
+}
+
+func (s *byteSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+func (s *byteSource) Seed(int64) {}
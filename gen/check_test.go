@@ -0,0 +1,103 @@
+package gen_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/gen"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestCheckPasses(t *testing.T) {
+	rec := &recorder{TB: t}
+	gen.Check(rec, gen.Slice(gen.Int(), 10), func(xs []int) bool { return len(xs) <= 10 }, nil)
+	if len(rec.errors) != 0 {
+		t.Errorf("Check reported %v for a property that holds", rec.errors)
+	}
+}
+
+func TestCheckShrinks(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(tb testing.TB)
+		want string
+	}{
+		{
+			name: "int",
+			run: func(tb testing.TB) {
+				gen.Check(tb, gen.IntRange(0, 1000), func(i int) bool { return i < 100 }, &gen.Config{Seed: 1})
+			},
+			want: "shrunk:   100",
+		},
+		{
+			name: "slice",
+			run: func(tb testing.TB) {
+				gen.Check(tb, gen.Slice(gen.IntRange(0, 100), 20), func(xs []int) bool {
+					for _, x := range xs {
+						if x >= 10 {
+							return false
+						}
+					}
+					return true
+				}, &gen.Config{Seed: 1})
+			},
+			want: "shrunk:   []int{10}",
+		},
+		{
+			name: "option",
+			run: func(tb testing.TB) {
+				gen.Check(tb, gen.Option(gen.Int()), func(o option.Option[int]) bool {
+					return o.IsNone()
+				}, &gen.Config{Seed: 1})
+			},
+			want: "shrunk:   option.Some[int](0)",
+		},
+		{
+			name: "panic",
+			run: func(tb testing.TB) {
+				gen.Check(tb, gen.String(10), func(s string) bool {
+					return s[len(s)-1] != 'z'
+				}, &gen.Config{Seed: 1})
+			},
+			want: `shrunk:   ""`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{TB: t}
+			tt.run(rec)
+			if len(rec.errors) != 1 {
+				t.Fatalf("Check reported %d failures, want 1", len(rec.errors))
+			}
+			if got := rec.errors[0]; !strings.Contains(got, tt.want) || !strings.Contains(got, "(seed 1)") {
+				t.Errorf("Check reported\n%s\nwant it to contain %q and the seed", got, tt.want)
+			}
+		})
+	}
+}
+
+func FuzzDecode(f *testing.F) {
+	f.Add([]byte{3, 0, 0, 0, 0, 0, 0, 0, 42})
+	gen.Fuzz(f, gen.Slice(gen.Option(gen.IntRange(-10, 10)), 5), func(t *testing.T, xs []option.Option[int]) {
+		if len(xs) > 5 {
+			t.Errorf("decoded %d elements, want at most 5", len(xs))
+		}
+		for _, x := range xs {
+			if v := x.GetOrElse(func() int { return 0 }); v < -10 || v > 10 {
+				t.Errorf("decoded %d, want it in [-10, 10]", v)
+			}
+		}
+	})
+}
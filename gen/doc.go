@@ -0,0 +1,41 @@
+/*
+Package gen provides composable random generators, with shrinking, for property-based tests and fuzzing of
+monadic code.
+
+A Gen produces a random value together with the ways it can be shrunk. Generators are combined with Map, Bind,
+OneOf and Frequency, and shrinking is carried through every combinator, so a failing Option shrinks toward None,
+a failing slice toward an empty or shorter one, and a failing number toward zero.
+
+gen consists of:
+
+	Gen[T]: A generator, with Const, FromSample, Int, IntRange, Bool, Rune, String, Slice and Iter.
+	Map/Bind/OneOf/Frequency: Combine generators.
+	Option/Result/Either: Generate the gonads monads, with Results holding a sampled error.
+	Check: Run a property against generated values, reporting the smallest failing value found.
+	Fuzz/Decode: Drive a generator from the []byte input of a native Go fuzz test.
+
+Usage Example:
+
+	var users = gen.Map(gen.String(8), func(name string) User { return User{Name: name} })
+
+	func TestLookup(t *testing.T) {
+	    gen.Check(t, gen.Option(users), func(u option.Option[User]) bool {
+	        return describe(u) != ""
+	    }, nil)
+	}
+
+	func FuzzLookup(f *testing.F) {
+	    gen.Fuzz(f, gen.Option(users), func(t *testing.T, u option.Option[User]) {
+	        if describe(u) == "" {
+	            t.Errorf("empty description for %v", u)
+	        }
+	    })
+	}
+
+In this example, the same generator drives both a seeded property check and the fuzzer. An empty fuzz input decodes
+to the smallest value, None, and the fuzzer's own minimisation of its input shrinks failures toward it.
+
+A Gen also works with lawtest, whose generators carry no shrinks: pass g.Sample where a lawtest.Gen is expected,
+and wrap a lawtest.Gen with FromSample to use it here.
+*/
+package gen
@@ -0,0 +1,201 @@
+package gen
+
+import (
+	"math/rand"
+
+	"github.com/alsi-lawr/gonads/iters"
+)
+
+// Gen generates random values, each with its shrinks.
+//
+// Type signature:
+//
+//	Gen[T] :: Rand -> Tree a
+type Gen[T any] func(r *rand.Rand) Tree[T]
+
+// Sample generates a single value, discarding its shrinks.
+//
+// Type signature:
+//
+//	Sample :: Gen a -> Rand -> a
+func (g Gen[T]) Sample(r *rand.Rand) T {
+	return g(r).Value
+}
+
+// FromSample creates a Gen from a function that generates plain values, such as a lawtest.Gen.
+//
+// Type signature:
+//
+//	FromSample :: (Rand -> a) -> Gen a
+//
+// The values it generates have no shrinks. In the other direction, the Sample method of a Gen is itself a
+// lawtest.Gen, so g.Sample can be passed wherever lawtest expects one.
+func FromSample[T any](sample func(r *rand.Rand) T) Gen[T] {
+	return func(r *rand.Rand) Tree[T] { return Leaf(sample(r)) }
+}
+
+// Const creates a Gen that always generates val.
+//
+// Type signature:
+//
+//	Const :: a -> Gen a
+func Const[T any](val T) Gen[T] {
+	return func(*rand.Rand) Tree[T] { return Leaf(val) }
+}
+
+// Map applies a function to every generated value, and to every value it shrinks to.
+//
+// Type signature:
+//
+//	Map :: Gen a -> (a -> b) -> Gen b
+func Map[T, U any](g Gen[T], f func(T) U) Gen[U] {
+	return func(r *rand.Rand) Tree[U] { return mapTree(g(r), f) }
+}
+
+// Bind generates a value, then generates from the Gen that f returns for it.
+//
+// Type signature:
+//
+//	Bind :: Gen a -> (a -> Gen b) -> Gen b
+//
+// Shrinking tries smaller values from g first, regenerating from f for each, and then shrinks the value from f.
+func Bind[T, U any](g Gen[T], f func(T) Gen[U]) Gen[U] {
+	return func(r *rand.Rand) Tree[U] {
+		outer := g(r)
+		seed := r.Int63()
+		regen := func(val T) Tree[U] { return f(val)(rand.New(rand.NewSource(seed))) }
+		return bindTree(outer, f(outer.Value)(r), regen)
+	}
+}
+
+// OneOf picks one of several Gens with equal probability.
+//
+// Type signature:
+//
+//	OneOf :: [Gen a] -> Gen a
+//
+// Shrinking tries the earlier Gens first. OneOf panics if no Gens are given.
+func OneOf[T any](gens ...Gen[T]) Gen[T] {
+	choices := make([]Weighted[T], len(gens))
+	for i, g := range gens {
+		choices[i] = Weighted[T]{Weight: 1, Gen: g}
+	}
+	return Frequency(choices...)
+}
+
+// Weighted is a Gen with a relative weight, for use with Frequency.
+type Weighted[T any] struct {
+	Weight int
+	Gen    Gen[T]
+}
+
+// Frequency picks one of several Gens with probability proportional to its weight.
+//
+// Type signature:
+//
+//	Frequency :: [(Int, Gen a)] -> Gen a
+//
+// Shrinking tries the earlier Gens first. Frequency panics if no choice has a positive weight.
+func Frequency[T any](choices ...Weighted[T]) Gen[T] {
+	total := 0
+	for _, c := range choices {
+		total += max(c.Weight, 0)
+	}
+	if total == 0 {
+		panic("gen: Frequency needs at least one choice with a positive weight")
+	}
+	pick := func(r *rand.Rand) Tree[int] {
+		n := r.Intn(total)
+		for i, c := range choices {
+			if n -= max(c.Weight, 0); n < 0 {
+				return intTree(i, 0)
+			}
+		}
+		panic("unreachable")
+	}
+	return Bind(pick, func(i int) Gen[T] { return choices[i].Gen })
+}
+
+// Int generates integers of any size, shrinking toward zero.
+//
+// Type signature:
+//
+//	Int :: Gen Int
+func Int() Gen[int] {
+	return func(r *rand.Rand) Tree[int] { return intTree(int(r.Uint64()), 0) }
+}
+
+// IntRange generates integers between lo and hi inclusive, shrinking toward the value in range closest to zero.
+//
+// Type signature:
+//
+//	IntRange :: Int -> Int -> Gen Int
+//
+// IntRange panics if hi is less than lo.
+func IntRange(lo, hi int) Gen[int] {
+	if hi < lo {
+		panic("gen: IntRange with hi less than lo")
+	}
+	origin := min(max(0, lo), hi)
+	span := uint64(hi-lo) + 1
+	return func(r *rand.Rand) Tree[int] {
+		// Offsets are counted from origin, wrapping past hi back to lo, so a zero draw generates origin.
+		offset := uint64(origin-lo) + r.Uint64()
+		if span != 0 {
+			offset %= span
+		}
+		return intTree(lo+int(offset), origin)
+	}
+}
+
+// Bool generates booleans, shrinking true toward false.
+//
+// Type signature:
+//
+//	Bool :: Gen Bool
+func Bool() Gen[bool] {
+	return Map(IntRange(0, 1), func(i int) bool { return i == 1 })
+}
+
+// Rune generates printable ASCII characters, shrinking toward 'a'.
+//
+// Type signature:
+//
+//	Rune :: Gen Rune
+func Rune() Gen[rune] {
+	return Map(IntRange(' '-'a', '~'-'a'), func(i int) rune { return rune('a' + i) })
+}
+
+// String generates strings of printable ASCII characters of up to maxLen characters,
+// shrinking toward shorter strings and simpler characters.
+//
+// Type signature:
+//
+//	String :: Int -> Gen String
+func String(maxLen int) Gen[string] {
+	return Map(Slice(Rune(), maxLen), func(rs []rune) string { return string(rs) })
+}
+
+// Slice generates slices of up to maxLen values from g, shrinking toward shorter slices and smaller values.
+//
+// Type signature:
+//
+//	Slice :: Gen a -> Int -> Gen [a]
+func Slice[T any](g Gen[T], maxLen int) Gen[[]T] {
+	return func(r *rand.Rand) Tree[[]T] {
+		elems := make([]Tree[T], r.Intn(max(maxLen, 0)+1))
+		for i := range elems {
+			elems[i] = g(r)
+		}
+		return sliceTree(elems)
+	}
+}
+
+// Iter generates Iters of up to maxLen values from g, shrinking toward shorter Iters and smaller values.
+//
+// Type signature:
+//
+//	Iter :: Gen a -> Int -> Gen (Iter a)
+func Iter[T any](g Gen[T], maxLen int) Gen[iters.Iter[T]] {
+	return Map(Slice(g, maxLen), iters.LiftSlice[T])
+}
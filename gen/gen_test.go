@@ -0,0 +1,271 @@
+package gen_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/gen"
+	"github.com/alsi-lawr/gonads/lawtest"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func newRand() *rand.Rand {
+	return rand.New(rand.NewSource(1))
+}
+
+func values[T any](trees []gen.Tree[T]) []T {
+	out := make([]T, len(trees))
+	for i, t := range trees {
+		out[i] = t.Value
+	}
+	return out
+}
+
+func errOf[T any](res result.Result[T]) error {
+	_, err := res.Unpack()
+	return err
+}
+
+func TestIntRangeStaysInRange(t *testing.T) {
+	r := newRand()
+	g := gen.IntRange(-5, 7)
+	seen := map[int]bool{}
+	for i := 0; i < 1000; i++ {
+		v := g.Sample(r)
+		if v < -5 || v > 7 {
+			t.Fatalf("IntRange(-5, 7) generated %d", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 13 {
+		t.Errorf("IntRange(-5, 7) generated %d distinct values, want 13", len(seen))
+	}
+}
+
+func TestIntShrinksTowardOrigin(t *testing.T) {
+	tree := gen.Const(0)(newRand())
+	if len(tree.Shrinks()) != 0 {
+		t.Errorf("Const shrinks = %v, want none", values(tree.Shrinks()))
+	}
+
+	g := gen.IntRange(3, 10)
+	for r := newRand(); ; {
+		tree := g(r)
+		if tree.Value != 10 {
+			continue
+		}
+		if got, want := values(tree.Shrinks()), []int{3, 7, 9}; !reflect.DeepEqual(got, want) {
+			t.Errorf("shrinks of 10 in [3, 10] = %v, want %v", got, want)
+		}
+		break
+	}
+}
+
+func TestSliceShrinksTowardEmpty(t *testing.T) {
+	r := newRand()
+	g := gen.Slice(gen.IntRange(0, 9), 5)
+	for {
+		tree := g(r)
+		if len(tree.Value) < 3 {
+			continue
+		}
+		shrinks := tree.Shrinks()
+		if len(shrinks[0].Value) != 0 {
+			t.Errorf("first shrink of %v = %v, want empty", tree.Value, shrinks[0].Value)
+		}
+		for _, s := range shrinks {
+			if len(s.Value) > len(tree.Value) {
+				t.Errorf("shrink %v of %v is longer", s.Value, tree.Value)
+			}
+		}
+		break
+	}
+}
+
+func TestOptionShrinksToNone(t *testing.T) {
+	r := newRand()
+	g := gen.Option(gen.IntRange(1, 9))
+	for {
+		tree := g(r)
+		if tree.Value.IsNone() {
+			if len(tree.Shrinks()) != 0 {
+				t.Errorf("None shrinks = %v, want none", values(tree.Shrinks()))
+			}
+			continue
+		}
+		if first := tree.Shrinks()[0].Value; !first.IsNone() {
+			t.Errorf("first shrink of %v = %v, want None", tree.Value, first)
+		}
+		break
+	}
+}
+
+func TestResultSamplesErrors(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	r := newRand()
+	g := gen.Result(gen.Int(), errA, errB)
+	seen := map[error]bool{}
+	oks := 0
+	for i := 0; i < 400; i++ {
+		res := g.Sample(r)
+		if res.IsOk() {
+			oks++
+			continue
+		}
+		seen[errOf(res)] = true
+	}
+	if !seen[errA] || !seen[errB] || len(seen) != 2 {
+		t.Errorf("sampled errors = %v, want exactly a and b", seen)
+	}
+	if oks < 250 || oks > 350 {
+		t.Errorf("Result generated %d Oks out of 400, want about 300", oks)
+	}
+
+	tree := gen.Result(gen.Const(1))(newRand())
+	for tree.Value.IsErr() {
+		tree = gen.Result(gen.Const(1))(newRand())
+	}
+	if got := tree.Shrinks()[0].Value; !got.IsErr() || !errors.Is(errOf(got), gen.ErrSampled) {
+		t.Errorf("first shrink of Ok = %v, want Err(ErrSampled)", got)
+	}
+}
+
+func TestEitherGeneratesBothSides(t *testing.T) {
+	r := newRand()
+	g := gen.Either(gen.Const("left"), gen.IntRange(0, 3))
+	lefts, rights := 0, 0
+	for i := 0; i < 200; i++ {
+		g.Sample(r).Match(func(string) { lefts++ }, func(int) { rights++ })
+	}
+	if lefts == 0 || rights == 0 {
+		t.Errorf("Either generated %d Lefts and %d Rights, want both", lefts, rights)
+	}
+}
+
+func TestMapAndBindKeepShrinking(t *testing.T) {
+	doubled := gen.Map(gen.IntRange(0, 100), func(i int) int { return i * 2 })
+	for _, s := range doubled(newRand()).Shrinks() {
+		if s.Value%2 != 0 {
+			t.Errorf("shrink of doubled value = %d, want even", s.Value)
+		}
+	}
+
+	// A slice whose length is generated first: shrinking the length regenerates a shorter slice.
+	sized := gen.Bind(gen.IntRange(0, 10), func(n int) gen.Gen[[]int] {
+		return func(r *rand.Rand) gen.Tree[[]int] { return gen.Leaf(make([]int, n)) }
+	})
+	r := newRand()
+	tree := sized(r)
+	for len(tree.Value) < 2 {
+		tree = sized(r)
+	}
+	if first := tree.Shrinks()[0].Value; len(first) != 0 {
+		t.Errorf("first shrink of Bind = %v, want empty", first)
+	}
+}
+
+func TestOneOfAndFrequency(t *testing.T) {
+	r := newRand()
+	g := gen.OneOf(gen.Const("a"), gen.Const("b"), gen.Const("c"))
+	seen := map[string]bool{}
+	for i := 0; i < 100; i++ {
+		seen[g.Sample(r)] = true
+	}
+	if len(seen) != 3 {
+		t.Errorf("OneOf generated %v, want all of a, b and c", seen)
+	}
+
+	freq := gen.Frequency(
+		gen.Weighted[string]{Weight: 1, Gen: gen.Const("rare")},
+		gen.Weighted[string]{Weight: 0, Gen: gen.Const("never")},
+		gen.Weighted[string]{Weight: 9, Gen: gen.Const("common")},
+	)
+	counts := map[string]int{}
+	for i := 0; i < 1000; i++ {
+		counts[freq.Sample(r)]++
+	}
+	if counts["never"] != 0 || counts["rare"] < 50 || counts["rare"] > 150 {
+		t.Errorf("Frequency counts = %v, want about 100 rare and no never", counts)
+	}
+
+	tree := freq(r)
+	for tree.Value != "common" {
+		tree = freq(r)
+	}
+	if got := tree.Shrinks()[0].Value; got != "rare" {
+		t.Errorf("first shrink of a later choice = %q, want the first choice", got)
+	}
+}
+
+func TestFrequencyPanicsWithoutWeight(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Frequency with no positive weights did not panic")
+		}
+	}()
+	gen.Frequency(gen.Weighted[int]{Weight: 0, Gen: gen.Int()})
+}
+
+func TestStringAndIter(t *testing.T) {
+	r := newRand()
+	for i := 0; i < 100; i++ {
+		s := gen.String(8).Sample(r)
+		if len(s) > 8 {
+			t.Fatalf("String(8) generated %q", s)
+		}
+		for _, c := range s {
+			if c < ' ' || c > '~' {
+				t.Fatalf("String(8) generated non-printable %q", s)
+			}
+		}
+		if it := gen.Iter(gen.Int(), 4).Sample(r); len(it) > 4 {
+			t.Fatalf("Iter(Int(), 4) generated %v", it)
+		}
+	}
+}
+
+func TestDecode(t *testing.T) {
+	g := gen.Slice(gen.Option(gen.IntRange(-10, 10)), 5)
+	if got := gen.Decode(g, nil); len(got) != 0 {
+		t.Errorf("Decode(nil) = %v, want the empty slice", got)
+	}
+	if got := gen.Decode(gen.Option(gen.Int()), nil); !got.IsNone() {
+		t.Errorf("Decode(nil) = %v, want None", got)
+	}
+	if got := gen.Decode(gen.Result(gen.Int()), nil); !errors.Is(errOf(got), gen.ErrSampled) {
+		t.Errorf("Decode(nil) = %v, want Err(ErrSampled)", got)
+	}
+	if got := gen.Decode(gen.IntRange(-10, 10), nil); got != 0 {
+		t.Errorf("Decode(nil) = %d, want 0", got)
+	}
+	if got := gen.Decode(gen.IntRange(5, 10), nil); got != 5 {
+		t.Errorf("Decode(nil) = %d, want 5", got)
+	}
+
+	data := []byte("some fuzzer input with enough bytes to fill a few slices")
+	if a, b := gen.Decode(g, data), gen.Decode(g, data); !reflect.DeepEqual(a, b) {
+		t.Errorf("Decode is not deterministic: %v and %v", a, b)
+	}
+}
+
+var _ gen.Gen[either.Either[int, option.Option[result.Result[string]]]] = gen.Either(
+	gen.Int(), gen.Option(gen.Result(gen.String(3))),
+)
+
+func TestFromSample(t *testing.T) {
+	g := gen.FromSample(lawtest.GenOption(lawtest.Arbitrary[int]()))
+	want := lawtest.GenOption(lawtest.Arbitrary[int]())(newRand())
+	tree := g(newRand())
+	if !option.Equal(tree.Value, want) || len(tree.Shrinks()) != 0 {
+		t.Errorf("FromSample() = %v with %d shrinks, want %v without shrinks", tree.Value, len(tree.Shrinks()), want)
+	}
+
+	var sample lawtest.Gen[int] = gen.Int().Sample
+	if got, want := sample(newRand()), gen.Int()(newRand()).Value; got != want {
+		t.Errorf("Sample() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package gen
+
+import (
+	"errors"
+	"math/rand"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// ErrSampled is the error held by generated Results when Result is given no errors to sample from.
+var ErrSampled = errors.New("gen: sampled error")
+
+// Option generates Options that are None a quarter of the time and otherwise Some of a value from g.
+//
+// Type signature:
+//
+//	Option :: Gen a -> Gen (Option a)
+//
+// A Some shrinks to None first, and then to Some of each of its value's shrinks.
+func Option[T any](g Gen[T]) Gen[option.Option[T]] {
+	return func(r *rand.Rand) Tree[option.Option[T]] {
+		if r.Intn(4) == 0 {
+			return Leaf(option.None[T]())
+		}
+		return someTree(g(r))
+	}
+}
+
+func someTree[T any](t Tree[T]) Tree[option.Option[T]] {
+	return Tree[option.Option[T]]{Value: option.Some(t.Value), shrinks: func() []Tree[option.Option[T]] {
+		out := []Tree[option.Option[T]]{Leaf(option.None[T]())}
+		for _, s := range t.Shrinks() {
+			out = append(out, someTree(s))
+		}
+		return out
+	}}
+}
+
+// Result generates Results that are an Err a quarter of the time and otherwise Ok of a value from g.
+// Each Err holds an error sampled from errs, or ErrSampled if errs is empty.
+//
+// Type signature:
+//
+//	Result :: Gen a -> [Error] -> Gen (Result a)
+//
+// An Ok shrinks to an Err of the first error first, and then to Ok of each of its value's shrinks.
+// An Err shrinks toward the first error.
+func Result[T any](g Gen[T], errs ...error) Gen[result.Result[T]] {
+	if len(errs) == 0 {
+		errs = []error{ErrSampled}
+	}
+	return func(r *rand.Rand) Tree[result.Result[T]] {
+		if r.Intn(4) == 0 {
+			return mapTree(intTree(r.Intn(len(errs)), 0), func(i int) result.Result[T] { return result.Err[T](errs[i]) })
+		}
+		return okTree(g(r), errs[0])
+	}
+}
+
+func okTree[T any](t Tree[T], err error) Tree[result.Result[T]] {
+	return Tree[result.Result[T]]{Value: result.Ok(t.Value), shrinks: func() []Tree[result.Result[T]] {
+		out := []Tree[result.Result[T]]{Leaf(result.Err[T](err))}
+		for _, s := range t.Shrinks() {
+			out = append(out, okTree(s, err))
+		}
+		return out
+	}}
+}
+
+// Either generates Eithers that are Left of a value from left or Right of a value from right with equal probability.
+//
+// Type signature:
+//
+//	Either :: Gen l -> Gen r -> Gen (Either l r)
+//
+// Each side shrinks through its own value's shrinks, keeping the same side.
+func Either[L, R any](left Gen[L], right Gen[R]) Gen[either.Either[L, R]] {
+	return func(r *rand.Rand) Tree[either.Either[L, R]] {
+		if r.Intn(2) == 0 {
+			return mapTree(left(r), either.Left[R, L])
+		}
+		return mapTree(right(r), either.Right[L, R])
+	}
+}
@@ -0,0 +1,94 @@
+package gen
+
+// Tree is a generated value together with the smaller values it can shrink to, in the order they should be tried.
+//
+// Type signature:
+//
+//	Tree[T] :: (a, [Tree a])
+//
+// Shrinks are computed lazily, only when they are needed.
+type Tree[T any] struct {
+	Value   T
+	shrinks func() []Tree[T]
+}
+
+// Leaf creates a Tree for a value that cannot be shrunk.
+//
+// Type signature:
+//
+//	Leaf :: a -> Tree a
+func Leaf[T any](val T) Tree[T] {
+	return Tree[T]{Value: val}
+}
+
+// Shrinks returns the Trees of the values this one can shrink to, simplest first.
+//
+// Type signature:
+//
+//	Shrinks :: Tree a -> [Tree a]
+func (t Tree[T]) Shrinks() []Tree[T] {
+	if t.shrinks == nil {
+		return nil
+	}
+	return t.shrinks()
+}
+
+func mapTree[T, U any](t Tree[T], f func(T) U) Tree[U] {
+	return Tree[U]{Value: f(t.Value), shrinks: func() []Tree[U] {
+		shrinks := t.Shrinks()
+		out := make([]Tree[U], len(shrinks))
+		for i, s := range shrinks {
+			out[i] = mapTree(s, f)
+		}
+		return out
+	}}
+}
+
+// bindTree combines an outer Tree with the inner Tree generated from its value. Shrinking tries the outer
+// value first, regenerating the inner Tree for each candidate, and then shrinks the inner value.
+func bindTree[T, U any](outer Tree[T], inner Tree[U], regen func(T) Tree[U]) Tree[U] {
+	return Tree[U]{Value: inner.Value, shrinks: func() []Tree[U] {
+		var out []Tree[U]
+		for _, s := range outer.Shrinks() {
+			out = append(out, bindTree(s, regen(s.Value), regen))
+		}
+		return append(out, inner.Shrinks()...)
+	}}
+}
+
+// intTree creates a Tree for v that shrinks toward origin, trying origin first and then halving the distance.
+func intTree(v, origin int) Tree[int] {
+	return Tree[int]{Value: v, shrinks: func() []Tree[int] {
+		var out []Tree[int]
+		for d := v - origin; d != 0; d /= 2 {
+			out = append(out, intTree(v-d, origin))
+		}
+		return out
+	}}
+}
+
+// sliceTree creates a Tree for a slice that shrinks by removing chunks of elements, largest first,
+// and then by shrinking individual elements.
+func sliceTree[T any](elems []Tree[T]) Tree[[]T] {
+	vals := make([]T, len(elems))
+	for i, e := range elems {
+		vals[i] = e.Value
+	}
+	return Tree[[]T]{Value: vals, shrinks: func() []Tree[[]T] {
+		var out []Tree[[]T]
+		for k := len(elems); k > 0; k /= 2 {
+			for i := 0; i+k <= len(elems); i += k {
+				rest := append(append([]Tree[T]{}, elems[:i]...), elems[i+k:]...)
+				out = append(out, sliceTree(rest))
+			}
+		}
+		for i, e := range elems {
+			for _, s := range e.Shrinks() {
+				next := append([]Tree[T]{}, elems...)
+				next[i] = s
+				out = append(out, sliceTree(next))
+			}
+		}
+		return out
+	}}
+}
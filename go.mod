@@ -1,3 +1,3 @@
 module github.com/alsi-lawr/gonads
 
-go 1.20.0
+go 1.21
@@ -0,0 +1,123 @@
+package gonadstest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// maxIterDiffs is the number of differing elements AssertIterEqual reports before summarising the rest.
+const maxIterDiffs = 10
+
+// AssertSome asserts that opt is Some holding a value deeply equal to want, and returns the value.
+func AssertSome[T any](t testing.TB, opt option.Option[T], want T) T {
+	t.Helper()
+	if opt.IsNone() {
+		t.Errorf("AssertSome: got None, want Some\n%s", mismatch("None", fmt.Sprintf("Some(%#v)", want)))
+		return want
+	}
+	got := *opt.GetOrNil()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AssertSome: value mismatch\n%s", mismatch(fmt.Sprintf("Some(%#v)", got), fmt.Sprintf("Some(%#v)", want)))
+	}
+	return got
+}
+
+// AssertNone asserts that opt is None.
+func AssertNone[T any](t testing.TB, opt option.Option[T]) {
+	t.Helper()
+	if opt.IsSome() {
+		t.Errorf("AssertNone: got Some, want None\n%s", mismatch(fmt.Sprintf("Some(%#v)", *opt.GetOrNil()), "None"))
+	}
+}
+
+// AssertOk asserts that res is Ok holding a value deeply equal to want, and returns the value.
+func AssertOk[T any](t testing.TB, res result.Result[T], want T) T {
+	t.Helper()
+	got, err := res.Unpack()
+	if res.IsErr() {
+		t.Errorf("AssertOk: got Err, want Ok\n%s", mismatch(fmt.Sprintf("Err(%v)", err), fmt.Sprintf("Ok(%#v)", want)))
+		return want
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AssertOk: value mismatch\n%s", mismatch(fmt.Sprintf("Ok(%#v)", got), fmt.Sprintf("Ok(%#v)", want)))
+	}
+	return got
+}
+
+// AssertErrIs asserts that res is Err holding an error that matches target under errors.Is, and returns the error.
+func AssertErrIs[T any](t testing.TB, res result.Result[T], target error) error {
+	t.Helper()
+	got, err := res.Unpack()
+	if res.IsOk() {
+		t.Errorf("AssertErrIs: got Ok, want Err\n%s", mismatch(fmt.Sprintf("Ok(%#v)", got), fmt.Sprintf("Err(%v)", target)))
+		return nil
+	}
+	if !errors.Is(err, target) {
+		t.Errorf("AssertErrIs: error does not match target\n%s", mismatch(fmt.Sprintf("Err(%v)", err), fmt.Sprintf("Err(%v)", target)))
+	}
+	return err
+}
+
+// AssertLeft asserts that e is a Left holding a value deeply equal to want, and returns the value.
+func AssertLeft[L, R any](t testing.TB, e either.Either[L, R], want L) L {
+	t.Helper()
+	if e.IsRight() {
+		t.Errorf("AssertLeft: got Right, want Left\n%s", mismatch(fmt.Sprintf("Right(%#v)", *e.RightOrNil()), fmt.Sprintf("Left(%#v)", want)))
+		return want
+	}
+	got := *e.LeftOrNil()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AssertLeft: value mismatch\n%s", mismatch(fmt.Sprintf("Left(%#v)", got), fmt.Sprintf("Left(%#v)", want)))
+	}
+	return got
+}
+
+// AssertRight asserts that e is a Right holding a value deeply equal to want, and returns the value.
+func AssertRight[L, R any](t testing.TB, e either.Either[L, R], want R) R {
+	t.Helper()
+	if e.IsLeft() {
+		t.Errorf("AssertRight: got Left, want Right\n%s", mismatch(fmt.Sprintf("Left(%#v)", *e.LeftOrNil()), fmt.Sprintf("Right(%#v)", want)))
+		return want
+	}
+	got := *e.RightOrNil()
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("AssertRight: value mismatch\n%s", mismatch(fmt.Sprintf("Right(%#v)", got), fmt.Sprintf("Right(%#v)", want)))
+	}
+	return got
+}
+
+// AssertIterEqual asserts that got and want have the same length and deeply equal elements in the same order.
+// A nil Iter and an empty Iter are considered equal.
+func AssertIterEqual[T any](t testing.TB, got, want iters.Iter[T]) {
+	t.Helper()
+	var diffs []string
+	for i := 0; i < max(len(got), len(want)); i++ {
+		switch {
+		case i >= len(got):
+			diffs = append(diffs, fmt.Sprintf("    [%d] missing, want %#v", i, want[i]))
+		case i >= len(want):
+			diffs = append(diffs, fmt.Sprintf("    [%d] unexpected %#v", i, got[i]))
+		case !reflect.DeepEqual(got[i], want[i]):
+			diffs = append(diffs, fmt.Sprintf("    [%d] got %#v, want %#v", i, got[i], want[i]))
+		}
+	}
+	if len(diffs) == 0 {
+		return
+	}
+	if len(diffs) > maxIterDiffs {
+		diffs = append(diffs[:maxIterDiffs], fmt.Sprintf("    ... and %d more", len(diffs)-maxIterDiffs))
+	}
+	t.Errorf("AssertIterEqual: got %d elements, want %d\n%s", len(got), len(want), strings.Join(diffs, "\n"))
+}
+
+func mismatch(got, want string) string {
+	return fmt.Sprintf("    got:  %s\n    want: %s", got, want)
+}
@@ -0,0 +1,130 @@
+package gonadstest_test
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/gonadstest"
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// recorder captures failures instead of failing the test.
+type recorder struct {
+	testing.TB
+	failures []string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Errorf(format string, args ...any) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func expectPass(t *testing.T, assert func(t testing.TB)) {
+	t.Helper()
+	r := &recorder{}
+	assert(r)
+	if len(r.failures) > 0 {
+		t.Errorf("expected the assertion to pass, got:\n%s", strings.Join(r.failures, "\n"))
+	}
+}
+
+func expectFail(t *testing.T, want string, assert func(t testing.TB)) {
+	t.Helper()
+	r := &recorder{}
+	assert(r)
+	if len(r.failures) != 1 {
+		t.Fatalf("expected one failure, got %d: %q", len(r.failures), r.failures)
+	}
+	if r.failures[0] != want {
+		t.Errorf("unexpected failure message:\n%s\nwant:\n%s", r.failures[0], want)
+	}
+}
+
+type user struct {
+	Name string
+}
+
+func TestAssertSome(t *testing.T) {
+	expectPass(t, func(t testing.TB) {
+		if got := gonadstest.AssertSome(t, option.Some(user{"ada"}), user{"ada"}); got.Name != "ada" {
+			t.Errorf("expected the value to be returned")
+		}
+	})
+	expectFail(t, "AssertSome: got None, want Some\n    got:  None\n    want: Some(42)", func(t testing.TB) {
+		gonadstest.AssertSome(t, option.None[int](), 42)
+	})
+	expectFail(t, "AssertSome: value mismatch\n    got:  Some(gonadstest_test.user{Name:\"bob\"})\n    want: Some(gonadstest_test.user{Name:\"ada\"})", func(t testing.TB) {
+		gonadstest.AssertSome(t, option.Some(user{"bob"}), user{"ada"})
+	})
+}
+
+func TestAssertNone(t *testing.T) {
+	expectPass(t, func(t testing.TB) { gonadstest.AssertNone(t, option.None[int]()) })
+	expectFail(t, "AssertNone: got Some, want None\n    got:  Some(\"x\")\n    want: None", func(t testing.TB) {
+		gonadstest.AssertNone(t, option.Some("x"))
+	})
+}
+
+func TestAssertOk(t *testing.T) {
+	expectPass(t, func(t testing.TB) { gonadstest.AssertOk(t, result.Ok([]int{1}), []int{1}) })
+	expectFail(t, "AssertOk: got Err, want Ok\n    got:  Err(unexpected EOF)\n    want: Ok(1)", func(t testing.TB) {
+		gonadstest.AssertOk(t, result.Err[int](io.ErrUnexpectedEOF), 1)
+	})
+	expectFail(t, "AssertOk: value mismatch\n    got:  Ok(2)\n    want: Ok(1)", func(t testing.TB) {
+		gonadstest.AssertOk(t, result.Ok(2), 1)
+	})
+}
+
+func TestAssertErrIs(t *testing.T) {
+	wrapped := fmt.Errorf("reading: %w", io.EOF)
+	expectPass(t, func(t testing.TB) {
+		if err := gonadstest.AssertErrIs(t, result.Err[int](wrapped), io.EOF); err != wrapped {
+			t.Errorf("expected the error to be returned")
+		}
+	})
+	expectFail(t, "AssertErrIs: got Ok, want Err\n    got:  Ok(1)\n    want: Err(EOF)", func(t testing.TB) {
+		gonadstest.AssertErrIs(t, result.Ok(1), io.EOF)
+	})
+	expectFail(t, "AssertErrIs: error does not match target\n    got:  Err(other)\n    want: Err(EOF)", func(t testing.TB) {
+		gonadstest.AssertErrIs(t, result.Err[int](errors.New("other")), io.EOF)
+	})
+}
+
+func TestAssertLeftRight(t *testing.T) {
+	expectPass(t, func(t testing.TB) { gonadstest.AssertLeft(t, either.Left[int]("l"), "l") })
+	expectPass(t, func(t testing.TB) { gonadstest.AssertRight(t, either.Right[string](1), 1) })
+	expectFail(t, "AssertLeft: got Right, want Left\n    got:  Right(1)\n    want: Left(\"l\")", func(t testing.TB) {
+		gonadstest.AssertLeft(t, either.Right[string](1), "l")
+	})
+	expectFail(t, "AssertRight: value mismatch\n    got:  Right(2)\n    want: Right(1)", func(t testing.TB) {
+		gonadstest.AssertRight(t, either.Right[string](2), 1)
+	})
+	expectFail(t, "AssertRight: got Left, want Right\n    got:  Left(\"l\")\n    want: Right(1)", func(t testing.TB) {
+		gonadstest.AssertRight(t, either.Left[int]("l"), 1)
+	})
+}
+
+func TestAssertIterEqual(t *testing.T) {
+	expectPass(t, func(t testing.TB) { gonadstest.AssertIterEqual(t, iters.Iter[int]{1, 2}, iters.Iter[int]{1, 2}) })
+	expectPass(t, func(t testing.TB) { gonadstest.AssertIterEqual(t, nil, iters.Iter[int]{}) })
+	expectFail(t, "AssertIterEqual: got 3 elements, want 2\n    [1] got 5, want 2\n    [2] unexpected 3", func(t testing.TB) {
+		gonadstest.AssertIterEqual(t, iters.Iter[int]{1, 5, 3}, iters.Iter[int]{1, 2})
+	})
+	expectFail(t, "AssertIterEqual: got 0 elements, want 1\n    [0] missing, want \"a\"", func(t testing.TB) {
+		gonadstest.AssertIterEqual(t, nil, iters.Iter[string]{"a"})
+	})
+
+	long := make(iters.Iter[int], 12)
+	r := &recorder{}
+	gonadstest.AssertIterEqual(r, long, nil)
+	if len(r.failures) != 1 || !strings.HasSuffix(r.failures[0], "... and 2 more") {
+		t.Errorf("expected long diffs to be summarised, got %q", r.failures)
+	}
+}
@@ -0,0 +1,22 @@
+/*
+Package gonadstest provides test assertions for Option, Result, Either and Iter values.
+
+Each assertion reports a failure with t.Errorf, naming the variant and value that were found and the ones that were
+expected, so tests need no Match calls with t.Fatal inside closures. Values are compared with reflect.DeepEqual, and
+assertions that succeed return the unwrapped value for further checks.
+
+Usage Example:
+
+	func TestParse(t *testing.T) {
+	    user := gonadstest.AssertOk(t, parseUser(`{"name":"ada"}`), User{Name: "ada"})
+	    gonadstest.AssertErrIs(t, parseUser(`{`), io.ErrUnexpectedEOF)
+	    gonadstest.AssertNone(t, findUser(user.Name + "?"))
+	}
+
+A failure reads, for example:
+
+	AssertOk: got Err, want Ok
+	    got:  Err(unexpected EOF)
+	    want: Ok(gonadstest_test.User{Name:"ada"})
+*/
+package gonadstest
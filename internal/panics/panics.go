@@ -0,0 +1,37 @@
+/*
+Package panics provides the shared panic error type used by the panic-safe constructors
+of the option, result and either packages.
+*/
+package panics
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+// Error is an error recovered from a panic.
+// It holds the value passed to panic and the stack of the goroutine that panicked.
+type Error struct {
+	Value any
+	Stack []byte
+}
+
+// New creates an Error from a recovered value, capturing the current goroutine's stack.
+// It must be called from within the deferred function that recovered the panic for the stack to be useful.
+func New(value any) *Error {
+	return &Error{Value: value, Stack: debug.Stack()}
+}
+
+// Error returns a message describing the recovered panic value.
+func (e *Error) Error() string {
+	return fmt.Sprintf("recovered panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it is an error, allowing errors.Is and errors.As
+// to inspect the cause of the panic.
+func (e *Error) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package iters
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+var logLimit atomic.Int64
+
+func init() {
+	logLimit.Store(10)
+}
+
+// SetLogLimit sets the maximum number of elements an Iter includes when logged through slog.
+// The default limit is 10. A negative limit disables truncation.
+//
+// It is safe to call concurrently, and affects only values logged after the call.
+func SetLogLimit(n int) {
+	logLimit.Store(int64(n))
+}
+
+// LogValue implements slog.LogValuer.
+//
+// Type signature:
+//
+//	LogValue :: Iter T -> slog.Value
+//
+// The Iter is logged as a group with a "len" attribute holding its length and an "items" attribute
+// holding its elements, truncated to the limit set by SetLogLimit. When elements are dropped,
+// a "truncated" attribute is added with the number of elements omitted.
+func (s Iter[T]) LogValue() slog.Value {
+	limit := int(logLimit.Load())
+	if limit < 0 || limit >= len(s) {
+		return slog.GroupValue(slog.Int("len", len(s)), slog.Any("items", []T(s)))
+	}
+	return slog.GroupValue(
+		slog.Int("len", len(s)),
+		slog.Any("items", []T(s[:limit])),
+		slog.Int("truncated", len(s)-limit),
+	)
+}
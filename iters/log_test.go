@@ -0,0 +1,44 @@
+package iters_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/iters"
+)
+
+func logIter(s iters.Iter[int]) string {
+	var buf bytes.Buffer
+	slog.New(slog.NewTextHandler(&buf, nil)).Info("msg", "items", s)
+	out := buf.String()
+	return strings.TrimSpace(out[strings.Index(out, "items."):])
+}
+
+func TestLogValue(t *testing.T) {
+	got := logIter(iters.Iter[int]{1, 2, 3})
+	if got != "items.len=3 items.items=\"[1 2 3]\"" {
+		t.Errorf("LogValue() = %q", got)
+	}
+}
+
+func TestLogValueTruncated(t *testing.T) {
+	iters.SetLogLimit(2)
+	defer iters.SetLogLimit(10)
+
+	got := logIter(iters.Iter[int]{1, 2, 3, 4, 5})
+	if got != "items.len=5 items.items=\"[1 2]\" items.truncated=3" {
+		t.Errorf("LogValue() = %q", got)
+	}
+}
+
+func TestLogValueUnlimited(t *testing.T) {
+	iters.SetLogLimit(-1)
+	defer iters.SetLogLimit(10)
+
+	got := logIter(iters.Iter[int]{1, 2, 3, 4, 5})
+	if got != "items.len=5 items.items=\"[1 2 3 4 5]\"" {
+		t.Errorf("LogValue() = %q", got)
+	}
+}
@@ -0,0 +1,61 @@
+package iters
+
+import "github.com/alsi-lawr/gonads/monoid"
+
+// Concat combines every element of a slice with a monoid, starting from its identity.
+//
+// Type signature:
+//
+//	Concat :: Iter T -> Monoid T -> T
+//
+// It returns m.Empty() for an empty slice.
+func Concat[T any](s Iter[T], m monoid.Monoid[T]) T {
+	return Fold(s, m.Empty(), m.Combine)
+}
+
+// FoldMonoid maps each element of a slice into a monoid and combines the results, starting from its identity.
+//
+// Type signature:
+//
+//	FoldMonoid :: Iter T -> Monoid M -> (T -> M) -> M
+//
+// It returns m.Empty() for an empty slice.
+func FoldMonoid[T, M any](s Iter[T], m monoid.Monoid[M], f func(T) M) M {
+	return Fold(s, m.Empty(), func(acc M, v T) M { return m.Combine(acc, f(v)) })
+}
+
+// AggregateMonoid maps each element of each group into a monoid and combines the results per group.
+//
+// Type signature:
+//
+//	AggregateMonoid :: Grouping K [T] -> Monoid M -> (T -> M) -> Map K M
+func AggregateMonoid[K comparable, T, M any](g Grouping[K, T], m monoid.Monoid[M], f func(T) M) map[K]M {
+	return Aggregate(g, func(items Iter[T]) M { return FoldMonoid(items, m, f) })
+}
+
+// Concat combines every element of a slice with a monoid, starting from its identity.
+//
+// Type signature:
+//
+//	Concat :: Iter T -> Monoid T -> T
+func (s Iter[T]) Concat(m monoid.Monoid[T]) T {
+	return Concat(s, m)
+}
+
+// FoldMonoid maps each element of a slice into a monoid and combines the results, starting from its identity.
+//
+// Type signature:
+//
+//	FoldMonoid :: Mappable T A -> Monoid A -> (T -> A) -> A
+func (s Mappable[T, A]) FoldMonoid(m monoid.Monoid[A], f func(T) A) A {
+	return FoldMonoid(s.ToIter(), m, f)
+}
+
+// AggregateMonoid maps each element of each group into a monoid and combines the results per group.
+//
+// Type signature:
+//
+//	AggregateMonoid :: Aggregable K [T] -> Monoid R -> (T -> R) -> Map K R
+func (gq Aggregable[K, T, R]) AggregateMonoid(m monoid.Monoid[R], f func(T) R) map[K]R {
+	return AggregateMonoid(gq.ToGrouping(), m, f)
+}
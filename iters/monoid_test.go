@@ -0,0 +1,54 @@
+package iters_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/monoid"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestConcat(t *testing.T) {
+	if got := iters.Concat(iters.Iter[int]{1, 2, 3}, monoid.Sum[int]()); got != 6 {
+		t.Errorf("Concat() = %d, want 6", got)
+	}
+	if got := (iters.Iter[string]{"a", "b"}).Concat(monoid.String()); got != "ab" {
+		t.Errorf("Concat() = %q, want \"ab\"", got)
+	}
+	if got := iters.Concat(iters.Iter[int]{}, monoid.Product[int]()); got != 1 {
+		t.Errorf("Concat() of an empty slice = %d, want 1", got)
+	}
+}
+
+func TestFoldMonoid(t *testing.T) {
+	words := iters.Iter[string]{"", "go", "", "nads"}
+	got := iters.FoldMonoid(words, monoid.First[string](), func(s string) option.Option[string] {
+		if s == "" {
+			return option.None[string]()
+		}
+		return option.Some(s)
+	})
+	if !option.Equal(got, option.Some("go")) {
+		t.Errorf("FoldMonoid() = %v, want Some(go)", got)
+	}
+
+	lengths := iters.LiftMap[string, int](words).FoldMonoid(monoid.Sum[int](), func(s string) int { return len(s) })
+	if lengths != 6 {
+		t.Errorf("FoldMonoid() = %d, want 6", lengths)
+	}
+}
+
+func TestAggregateMonoid(t *testing.T) {
+	g := iters.Grouping[bool, int]{false: {1, 3}, true: {2, 4}}
+	got := iters.AggregateMonoid(g, monoid.Sum[int](), func(x int) int { return x })
+	if want := map[bool]int{false: 4, true: 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("AggregateMonoid() = %v, want %v", got, want)
+	}
+
+	maxes := iters.LiftAggregable[bool, int, option.Option[int]](g).
+		AggregateMonoid(monoid.Option(monoid.Max[int]()), option.Some[int])
+	if !option.Equal(maxes[true], option.Some(4)) || !option.Equal(maxes[false], option.Some(3)) {
+		t.Errorf("AggregateMonoid() = %v, want Some(3) and Some(4)", maxes)
+	}
+}
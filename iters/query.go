@@ -109,6 +109,37 @@ func Count[T any](s Iter[T], f func(T) bool) int {
 	return count
 }
 
+// Contains returns true if any element in the slice is equal to v under ==.
+//
+// Type signature:
+//
+//	Contains :: Iter T -> T -> bool
+func Contains[T comparable](s Iter[T], v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// ContainsFunc returns true if any element in the slice is equal to v under the comparator eq.
+//
+// Type signature:
+//
+//	ContainsFunc :: Iter T -> T -> ((T, T) -> bool) -> bool
+//
+// This allows values that are not comparable with ==, such as monads holding slices,
+// to be searched for with a caller-supplied equality such as option.EqualFunc.
+func ContainsFunc[T any](s Iter[T], v T, eq func(T, T) bool) bool {
+	for _, e := range s {
+		if eq(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
 // Find returns the first element in the slice that satisfies the predicate f.
 //
 // Type signature:
@@ -179,3 +210,12 @@ func (s Iter[T]) All(f func(T) bool) bool {
 func (s Iter[T]) Count(f func(T) bool) int {
 	return Count(s, f)
 }
+
+// ContainsFunc returns true if any element in the slice is equal to v under the comparator eq.
+//
+// Type signature:
+//
+//	ContainsFunc :: Iter T -> T -> ((T, T) -> bool) -> bool
+func (s Iter[T]) ContainsFunc(v T, eq func(T, T) bool) bool {
+	return ContainsFunc(s, v, eq)
+}
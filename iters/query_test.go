@@ -1,6 +1,7 @@
 package iters_test
 
 import (
+	"reflect"
 	"strings"
 	"testing"
 
@@ -334,3 +335,24 @@ func TestEmptySliceQueries(t *testing.T) {
 		t.Errorf("funcs.Count() on empty slice = %d, want 0", cnt)
 	}
 }
+
+func TestContains(t *testing.T) {
+	ints := iters.Iter[int]{1, 2, 3}
+	if !iters.Contains(ints, 2) {
+		t.Errorf("funcs.Contains() = false, want true")
+	}
+	if iters.Contains(ints, 4) {
+		t.Errorf("funcs.Contains() = true, want false")
+	}
+}
+
+func TestContainsFunc(t *testing.T) {
+	slices := iters.Iter[[]int]{{1}, {2, 3}}
+	eq := func(a, b []int) bool { return reflect.DeepEqual(a, b) }
+	if !iters.ContainsFunc(slices, []int{2, 3}, eq) {
+		t.Errorf("funcs.ContainsFunc() = false, want true")
+	}
+	if slices.ContainsFunc([]int{4}, eq) {
+		t.Errorf("funcs.ContainsFunc() = true, want false")
+	}
+}
@@ -0,0 +1,71 @@
+package iters
+
+// TapEach runs a side effect on each element of a slice, returning the slice unchanged.
+//
+// Type signature:
+//
+//	TapEach :: Iter T -> (T -> ()) -> Iter T
+//
+// The input slice is returned as-is, allowing the side effect to be inserted into a chain.
+func TapEach[T any](s Iter[T], f func(T)) Iter[T] {
+	for _, v := range s {
+		f(v)
+	}
+	return s
+}
+
+// TapEachI runs a side effect on each element of a slice along with its index, returning the slice unchanged.
+//
+// Type signature:
+//
+//	TapEachI :: Iter T -> ((Int, T) -> ()) -> Iter T
+//
+// The input slice is returned as-is, allowing the side effect to be inserted into a chain.
+func TapEachI[T any](s Iter[T], f func(int, T)) Iter[T] {
+	for i, v := range s {
+		f(i, v)
+	}
+	return s
+}
+
+// TapEachChan runs a side effect on each element received on a channel,
+// returning a new channel that outputs the same elements.
+//
+// Type signature:
+//
+//	TapEachChan :: Channel T -> (T -> ()) -> Channel T
+//
+// Each element is passed to f before being sent to the output channel.
+func TapEachChan[T any](c <-chan T, f func(T)) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		for v := range c {
+			f(v)
+			out <- v
+		}
+	}()
+	return out
+}
+
+// TapEach runs a side effect on each element of a slice, returning the slice unchanged.
+//
+// Type signature:
+//
+//	TapEach :: Iter T -> (T -> ()) -> Iter T
+//
+// The input slice is returned as-is, allowing the side effect to be inserted into a chain.
+func (s Iter[T]) TapEach(f func(T)) Iter[T] {
+	return TapEach(s, f)
+}
+
+// TapEachI runs a side effect on each element of a slice along with its index, returning the slice unchanged.
+//
+// Type signature:
+//
+//	TapEachI :: Iter T -> ((Int, T) -> ()) -> Iter T
+//
+// The input slice is returned as-is, allowing the side effect to be inserted into a chain.
+func (s Iter[T]) TapEachI(f func(int, T)) Iter[T] {
+	return TapEachI(s, f)
+}
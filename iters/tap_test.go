@@ -0,0 +1,72 @@
+package iters_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/iters"
+)
+
+func TestTapEachStatic(t *testing.T) {
+	input := iters.Iter[int]{1, 2, 3}
+	var seen []int
+	got := iters.TapEach(input, func(x int) { seen = append(seen, x) })
+	if !reflect.DeepEqual(got, input) {
+		t.Errorf("TapEach() = %v, want %v", got, input)
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3}) {
+		t.Errorf("TapEach() saw %v, want %v", seen, input)
+	}
+}
+
+func TestTapEachIStatic(t *testing.T) {
+	input := iters.Iter[string]{"a", "b"}
+	var seen []int
+	got := iters.TapEachI(input, func(i int, s string) { seen = append(seen, i) })
+	if !reflect.DeepEqual(got, input) {
+		t.Errorf("TapEachI() = %v, want %v", got, input)
+	}
+	if !reflect.DeepEqual(seen, []int{0, 1}) {
+		t.Errorf("TapEachI() saw indices %v, want %v", seen, []int{0, 1})
+	}
+}
+
+func TestTapEach(t *testing.T) {
+	count := 0
+	got := iters.Iter[int]{1, 2, 3, 4}.
+		TapEach(func(int) { count++ }).
+		Filter(func(x int) bool { return x%2 == 0 })
+	if count != 4 {
+		t.Errorf("TapEach() called %d times, want %d", count, 4)
+	}
+	if !reflect.DeepEqual(got, iters.Iter[int]{2, 4}) {
+		t.Errorf("TapEach() chain = %v, want %v", got, iters.Iter[int]{2, 4})
+	}
+}
+
+func TestTapEachI(t *testing.T) {
+	sum := 0
+	iters.Iter[int]{5, 5, 5}.TapEachI(func(i int, _ int) { sum += i })
+	if sum != 3 {
+		t.Errorf("TapEachI() index sum = %d, want %d", sum, 3)
+	}
+}
+
+func TestTapEachChan(t *testing.T) {
+	in := make(chan int, 3)
+	for _, n := range []int{1, 2, 3} {
+		in <- n
+	}
+	close(in)
+
+	var seen []int
+	out := iters.TapEachChan(in, func(x int) { seen = append(seen, x) })
+	var got []int
+	for x := range out {
+		got = append(got, x)
+	}
+	want := []int{1, 2, 3}
+	if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(seen, want) {
+		t.Errorf("TapEachChan() = %v (saw %v), want %v", got, seen, want)
+	}
+}
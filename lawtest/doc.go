@@ -0,0 +1,33 @@
+/*
+Package lawtest checks that functor and monad instances obey their laws, using randomly generated values in the
+style of testing/quick.
+
+A type is described to lawtest by a Functor or Monad value holding its operations, a way to compare values and
+generators for its contents. CheckFunctor and CheckMonad then run each law against many generated inputs and report
+any counterexample as a test failure. The same descriptions can be written for custom monads, so downstream code
+can verify its own types with the same harness.
+
+lawtest consists of:
+
+	Gen[T]: A generator of random values, with Arbitrary, Func and generators for Option, Result, Either and Iter.
+	Functor/CheckFunctor: The identity and composition laws for Map.
+	Monad/CheckMonad: The left identity, right identity and associativity laws for Bind.
+
+Usage Example:
+
+	func TestOptionMonad(t *testing.T) {
+	    lawtest.CheckMonad(t, lawtest.Monad[int, option.Option[int]]{
+	        Pure:  option.Some[int],
+	        Bind:  option.Bind[int, int],
+	        Equal: option.Equal[int],
+	        Gen:   lawtest.GenOption(lawtest.Arbitrary[int]()),
+	        GenA:  lawtest.Arbitrary[int](),
+	    }, nil)
+	}
+
+In this example, the laws are checked for 100 generated values and functions, the default of testing/quick.
+
+Generators from the gen package can be used as well, as the Sample method of a gen.Gen is a Gen, such as
+Gen: gen.Option(gen.Int()).Sample. gen.FromSample adapts a Gen the other way, without shrinking.
+*/
+package lawtest
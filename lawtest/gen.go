@@ -0,0 +1,121 @@
+package lawtest
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Gen generates random values of a type.
+//
+// Type signature:
+//
+//	Gen[T] :: Rand -> a
+//
+// The Sample method of a gen.Gen is a Gen, so shrinking generators from the gen package can be used here too.
+type Gen[T any] func(r *rand.Rand) T
+
+// Arbitrary generates values of any type supported by testing/quick.
+//
+// Type signature:
+//
+//	Arbitrary :: Gen a
+//
+// It panics when called if T is not supported by quick.Value.
+func Arbitrary[T any]() Gen[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	return func(r *rand.Rand) T {
+		v, ok := quick.Value(typ, r)
+		if !ok {
+			panic(fmt.Sprintf("lawtest: cannot generate values of type %v", typ))
+		}
+		return v.Interface().(T)
+	}
+}
+
+// Func generates pure functions whose results are drawn from g.
+//
+// Type signature:
+//
+//	Func :: Gen b -> Gen (a -> b)
+//
+// Each generated function always returns the same result for the same argument, as laws require.
+// Arguments are distinguished by their %#v formatting.
+func Func[A, B any](g Gen[B]) Gen[func(A) B] {
+	return func(r *rand.Rand) func(A) B {
+		seed := r.Int63()
+		return func(a A) B {
+			h := fnv.New64a()
+			fmt.Fprintf(h, "%#v", a)
+			return g(rand.New(rand.NewSource(seed ^ int64(h.Sum64()))))
+		}
+	}
+}
+
+// GenOption generates Options that are None a quarter of the time and otherwise Some of a value from g.
+//
+// Type signature:
+//
+//	GenOption :: Gen a -> Gen (Option a)
+func GenOption[T any](g Gen[T]) Gen[option.Option[T]] {
+	return func(r *rand.Rand) option.Option[T] {
+		if r.Intn(4) == 0 {
+			return option.None[T]()
+		}
+		return option.Some(g(r))
+	}
+}
+
+// Errors are the errors held by generated Results. They are fixed values, so generated Results can be compared with ==.
+var Errors = []error{errors.New("lawtest: error 1"), errors.New("lawtest: error 2"), errors.New("lawtest: error 3")}
+
+// GenResult generates Results that are an Err from Errors a quarter of the time and otherwise Ok of a value from g.
+//
+// Type signature:
+//
+//	GenResult :: Gen a -> Gen (Result a)
+func GenResult[T any](g Gen[T]) Gen[result.Result[T]] {
+	return func(r *rand.Rand) result.Result[T] {
+		if r.Intn(4) == 0 {
+			return result.Err[T](Errors[r.Intn(len(Errors))])
+		}
+		return result.Ok(g(r))
+	}
+}
+
+// GenEither generates Eithers that are a Left from gl a quarter of the time and otherwise a Right from gr.
+//
+// Type signature:
+//
+//	GenEither :: Gen l -> Gen r -> Gen (Either l r)
+func GenEither[L, R any](gl Gen[L], gr Gen[R]) Gen[either.Either[L, R]] {
+	return func(r *rand.Rand) either.Either[L, R] {
+		if r.Intn(4) == 0 {
+			return either.Left[R](gl(r))
+		}
+		return either.Right[L](gr(r))
+	}
+}
+
+// GenIter generates Iters of up to maxLen values from g.
+//
+// Type signature:
+//
+//	GenIter :: Gen a -> Int -> Gen (Iter a)
+func GenIter[T any](g Gen[T], maxLen int) Gen[iters.Iter[T]] {
+	return func(r *rand.Rand) iters.Iter[T] {
+		s := make(iters.Iter[T], r.Intn(maxLen+1))
+		for i := range s {
+			s[i] = g(r)
+		}
+		return s
+	}
+}
@@ -0,0 +1,142 @@
+package lawtest
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"testing/quick"
+	"time"
+)
+
+// Functor describes a functor F of A for checking the functor laws.
+type Functor[A, F any] struct {
+	// Map applies a function to the contents of a functor value.
+	Map func(fa F, fn func(A) A) F
+	// Equal reports whether two functor values are equal.
+	Equal func(a, b F) bool
+	// Gen generates functor values.
+	Gen Gen[F]
+	// GenA generates contents, and is used to generate the functions passed to Map.
+	GenA Gen[A]
+}
+
+// Monad describes a monad M of A for checking the monad laws.
+type Monad[A, M any] struct {
+	// Pure wraps a value in the monad.
+	Pure func(A) M
+	// Bind applies a function returning a monadic value to the contents of a monadic value.
+	Bind func(m M, fn func(A) M) M
+	// Equal reports whether two monadic values are equal.
+	Equal func(a, b M) bool
+	// Gen generates monadic values, and is used to generate the functions passed to Bind.
+	Gen Gen[M]
+	// GenA generates values to pass to Pure.
+	GenA Gen[A]
+}
+
+// CheckFunctor checks that f obeys the functor laws, reporting a failure on t for each law that is broken:
+//
+//	identity:    Map(fa, id) == fa
+//	composition: Map(fa, g . h) == Map(Map(fa, h), g)
+//
+// cfg controls the number of checks and the source of randomness, as for testing/quick. It may be nil.
+func CheckFunctor[A, F any](t testing.TB, f Functor[A, F], cfg *quick.Config) {
+	t.Helper()
+	if err := VerifyFunctor(f, cfg); err != nil {
+		t.Error(err)
+	}
+}
+
+// VerifyFunctor checks that f obeys the functor laws, returning an error describing a counterexample for
+// each law that is broken.
+func VerifyFunctor[A, F any](f Functor[A, F], cfg *quick.Config) error {
+	fn := Func[A](f.GenA)
+	return errors.Join(
+		verify(cfg, "functor identity", func(r *rand.Rand) error {
+			fa := f.Gen(r)
+			if got := f.Map(fa, func(a A) A { return a }); !f.Equal(got, fa) {
+				return fmt.Errorf("Map(%v, id) = %v", fa, got)
+			}
+			return nil
+		}),
+		verify(cfg, "functor composition", func(r *rand.Rand) error {
+			fa, g, h := f.Gen(r), fn(r), fn(r)
+			composed := f.Map(fa, func(a A) A { return g(h(a)) })
+			chained := f.Map(f.Map(fa, h), g)
+			if !f.Equal(composed, chained) {
+				return fmt.Errorf("for %v, Map(fa, g . h) = %v but Map(Map(fa, h), g) = %v", fa, composed, chained)
+			}
+			return nil
+		}),
+	)
+}
+
+// CheckMonad checks that m obeys the monad laws, reporting a failure on t for each law that is broken:
+//
+//	left identity:  Bind(Pure(a), f) == f(a)
+//	right identity: Bind(m, Pure) == m
+//	associativity:  Bind(Bind(m, f), g) == Bind(m, x -> Bind(f(x), g))
+//
+// cfg controls the number of checks and the source of randomness, as for testing/quick. It may be nil.
+func CheckMonad[A, M any](t testing.TB, m Monad[A, M], cfg *quick.Config) {
+	t.Helper()
+	if err := VerifyMonad(m, cfg); err != nil {
+		t.Error(err)
+	}
+}
+
+// VerifyMonad checks that m obeys the monad laws, returning an error describing a counterexample for
+// each law that is broken.
+func VerifyMonad[A, M any](m Monad[A, M], cfg *quick.Config) error {
+	fn := Func[A](m.Gen)
+	return errors.Join(
+		verify(cfg, "monad left identity", func(r *rand.Rand) error {
+			a, f := m.GenA(r), fn(r)
+			if got, want := m.Bind(m.Pure(a), f), f(a); !m.Equal(got, want) {
+				return fmt.Errorf("Bind(Pure(%v), f) = %v but f(%v) = %v", a, got, a, want)
+			}
+			return nil
+		}),
+		verify(cfg, "monad right identity", func(r *rand.Rand) error {
+			ma := m.Gen(r)
+			if got := m.Bind(ma, m.Pure); !m.Equal(got, ma) {
+				return fmt.Errorf("Bind(%v, Pure) = %v", ma, got)
+			}
+			return nil
+		}),
+		verify(cfg, "monad associativity", func(r *rand.Rand) error {
+			ma, f, g := m.Gen(r), fn(r), fn(r)
+			left := m.Bind(m.Bind(ma, f), g)
+			right := m.Bind(ma, func(a A) M { return m.Bind(f(a), g) })
+			if !m.Equal(left, right) {
+				return fmt.Errorf("for %v, Bind(Bind(m, f), g) = %v but Bind(m, x -> Bind(f(x), g)) = %v", ma, left, right)
+			}
+			return nil
+		}),
+	)
+}
+
+// verify runs a law check cfg.MaxCount times, stopping at the first counterexample.
+func verify(cfg *quick.Config, law string, check func(r *rand.Rand) error) error {
+	if cfg == nil {
+		cfg = &quick.Config{}
+	}
+	r := cfg.Rand
+	if r == nil {
+		r = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	count := cfg.MaxCount
+	if count == 0 {
+		count = 100
+		if cfg.MaxCountScale != 0 {
+			count = int(float64(count) * cfg.MaxCountScale)
+		}
+	}
+	for i := 0; i < count; i++ {
+		if err := check(r); err != nil {
+			return fmt.Errorf("%s does not hold after %d checks: %w", law, i+1, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package lawtest_test
+
+import (
+	"math/rand"
+	"testing"
+	"testing/quick"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/gen"
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/lawtest"
+	"github.com/alsi-lawr/gonads/nonempty"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+var (
+	genInt = lawtest.Arbitrary[int]()
+	cfg    = &quick.Config{MaxCount: 200, Rand: rand.New(rand.NewSource(1))}
+)
+
+func TestOptionLaws(t *testing.T) {
+	lawtest.CheckFunctor(t, lawtest.Functor[int, option.Option[int]]{
+		Map:   option.Map[int, int],
+		Equal: option.Equal[int],
+		Gen:   lawtest.GenOption(genInt),
+		GenA:  genInt,
+	}, cfg)
+	lawtest.CheckMonad(t, lawtest.Monad[int, option.Option[int]]{
+		Pure:  option.Some[int],
+		Bind:  option.Bind[int, int],
+		Equal: option.Equal[int],
+		Gen:   lawtest.GenOption(genInt),
+		GenA:  genInt,
+	}, cfg)
+}
+
+func TestResultLaws(t *testing.T) {
+	lawtest.CheckFunctor(t, lawtest.Functor[int, result.Result[int]]{
+		Map:   result.Map[int, int],
+		Equal: result.Equal[int],
+		Gen:   lawtest.GenResult(genInt),
+		GenA:  genInt,
+	}, cfg)
+	lawtest.CheckMonad(t, lawtest.Monad[int, result.Result[int]]{
+		Pure:  result.Ok[int],
+		Bind:  result.Bind[int, int],
+		Equal: result.Equal[int],
+		Gen:   lawtest.GenResult(genInt),
+		GenA:  genInt,
+	}, cfg)
+}
+
+func TestEitherLaws(t *testing.T) {
+	gen := lawtest.GenEither(lawtest.Arbitrary[string](), genInt)
+	lawtest.CheckFunctor(t, lawtest.Functor[int, either.Either[string, int]]{
+		Map:   either.RMap[string, int, int],
+		Equal: either.Equal[string, int],
+		Gen:   gen,
+		GenA:  genInt,
+	}, cfg)
+	lawtest.CheckMonad(t, lawtest.Monad[int, either.Either[string, int]]{
+		Pure:  either.Right[string, int],
+		Bind:  either.RBind[string, int, int],
+		Equal: either.Equal[string, int],
+		Gen:   gen,
+		GenA:  genInt,
+	}, cfg)
+}
+
+func iterEqual(a, b iters.Iter[int]) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestIterLaws(t *testing.T) {
+	gen := lawtest.GenIter(genInt, 5)
+	lawtest.CheckFunctor(t, lawtest.Functor[int, iters.Iter[int]]{
+		Map:   iters.Map[int, int],
+		Equal: iterEqual,
+		Gen:   gen,
+		GenA:  genInt,
+	}, cfg)
+	lawtest.CheckMonad(t, lawtest.Monad[int, iters.Iter[int]]{
+		Pure:  func(a int) iters.Iter[int] { return iters.Iter[int]{a} },
+		Bind:  iters.FlatMap[int, int],
+		Equal: iterEqual,
+		Gen:   gen,
+		GenA:  genInt,
+	}, cfg)
+}
+
+func TestNonEmptyLaws(t *testing.T) {
+	gen := func(r *rand.Rand) nonempty.NonEmpty[int] {
+		return nonempty.New(genInt(r), lawtest.GenIter(genInt, 4)(r)...)
+	}
+	equal := func(a, b nonempty.NonEmpty[int]) bool { return iterEqual(a.ToIter(), b.ToIter()) }
+	lawtest.CheckMonad(t, lawtest.Monad[int, nonempty.NonEmpty[int]]{
+		Pure:  func(a int) nonempty.NonEmpty[int] { return nonempty.New(a) },
+		Bind:  nonempty.FlatMap[int, int],
+		Equal: equal,
+		Gen:   gen,
+		GenA:  genInt,
+	}, cfg)
+}
+
+func TestBrokenInstancesAreReported(t *testing.T) {
+	// A Map that drops the value breaks the identity law.
+	broken := lawtest.Functor[int, option.Option[int]]{
+		Map:   func(o option.Option[int], fn func(int) int) option.Option[int] { return option.None[int]() },
+		Equal: option.Equal[int],
+		Gen:   lawtest.GenOption(genInt),
+		GenA:  genInt,
+	}
+	if err := lawtest.VerifyFunctor(broken, cfg); err == nil {
+		t.Errorf("expected a Map that discards values to break the functor laws")
+	}
+
+	// A Bind that ignores its function breaks left identity.
+	lazy := lawtest.Monad[int, option.Option[int]]{
+		Pure:  option.Some[int],
+		Bind:  func(o option.Option[int], fn func(int) option.Option[int]) option.Option[int] { return o },
+		Equal: option.Equal[int],
+		Gen:   lawtest.GenOption(genInt),
+		GenA:  genInt,
+	}
+	if err := lawtest.VerifyMonad(lazy, cfg); err == nil {
+		t.Errorf("expected a Bind that ignores its function to break the monad laws")
+	}
+}
+
+func TestFuncIsPure(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	f := lawtest.Func[string](genInt)(r)
+	if f("a") != f("a") {
+		t.Errorf("expected a generated function to return the same result for the same argument")
+	}
+	g := lawtest.Func[string](genInt)(r)
+	same := true
+	for _, s := range []string{"a", "b", "c", "d"} {
+		same = same && f(s) == g(s)
+	}
+	if same {
+		t.Errorf("expected independently generated functions to differ")
+	}
+}
+
+func TestGenGenerators(t *testing.T) {
+	lawtest.CheckMonad(t, lawtest.Monad[int, result.Result[int]]{
+		Pure:  result.Ok[int],
+		Bind:  result.Bind[int, int],
+		Equal: result.Equal[int],
+		Gen:   gen.Result(gen.Int()).Sample,
+		GenA:  gen.Int().Sample,
+	}, cfg)
+}
@@ -0,0 +1,31 @@
+/*
+Package lazy provides deferred, memoised computations.
+
+A Lazy value wraps a function that is not run until its value is first needed, and whose result is then
+cached for every later caller. Evaluation is safe for concurrent use: however many goroutines ask for the
+value, the function runs at most once.
+
+Lazy consists of:
+
+	Lazy[T]: A memoised computation of a T.
+	LazyResult[T]: A memoised computation that may fail, optionally retrying until it succeeds.
+
+Usage Example:
+
+	var db = lazy.NewResultRetry(func() (*sql.DB, error) {
+	    return sql.Open("postgres", os.Getenv("DATABASE_URL"))
+	}, 3)
+
+	func handler(w http.ResponseWriter, r *http.Request) {
+	    conn, err := db.Unpack()
+	    if err != nil {
+	        http.Error(w, err.Error(), http.StatusServiceUnavailable)
+	        return
+	    }
+	    // use conn
+	}
+
+In this example, the connection is opened on the first request rather than at start-up. A failed attempt is
+not cached, so a later request tries again.
+*/
+package lazy
@@ -0,0 +1,115 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Lazy represents a computation that is deferred until its value is first needed, and then memoised.
+//
+// Type signature:
+//
+//	Lazy[T] :: (() -> a) -> Lazy a
+//
+// A Lazy is safe for concurrent use. Its function runs at most once; if it panics, every call to Get panics with the same value.
+type Lazy[T any] struct {
+	get       func() T
+	evaluated atomic.Bool
+}
+
+// New creates a Lazy that computes its value with fn when first needed.
+//
+// Type signature:
+//
+//	New :: (() -> a) -> Lazy a
+func New[T any](fn func() T) *Lazy[T] {
+	l := &Lazy[T]{}
+	l.get = sync.OnceValue(func() T {
+		defer l.evaluated.Store(true)
+		return fn()
+	})
+	return l
+}
+
+// Value creates a Lazy that has already been evaluated to val.
+//
+// Type signature:
+//
+//	Value :: a -> Lazy a
+func Value[T any](val T) *Lazy[T] {
+	l := &Lazy[T]{get: func() T { return val }}
+	l.evaluated.Store(true)
+	return l
+}
+
+// Get returns the value of the Lazy, computing it if this is the first call.
+//
+// Type signature:
+//
+//	Get :: Lazy a -> a
+func (l *Lazy[T]) Get() T {
+	return l.get()
+}
+
+// IsEvaluated reports whether the value of the Lazy has been computed, without computing it.
+//
+// Type signature:
+//
+//	IsEvaluated :: Lazy a -> Bool
+func (l *Lazy[T]) IsEvaluated() bool {
+	return l.evaluated.Load()
+}
+
+// Map applies a function to the value of the Lazy, deferring both until the new value is needed.
+//
+// Type signature:
+//
+//	Map :: Lazy a -> (a -> a) -> Lazy a
+func (l *Lazy[T]) Map(fn func(T) T) *Lazy[T] {
+	return Map(l, fn)
+}
+
+// Map applies a function to the value of the Lazy, deferring both until the new value is needed.
+//
+// Type signature:
+//
+//	Map :: Lazy a -> (a -> b) -> Lazy b
+//
+// The original Lazy is evaluated at most once, however many Lazy values are mapped from it.
+func Map[T, U any](l *Lazy[T], fn func(T) U) *Lazy[U] {
+	return New(func() U { return fn(l.Get()) })
+}
+
+// Bind applies a function returning a Lazy to the value of the Lazy, deferring both until the new value is needed.
+//
+// Type signature:
+//
+//	Bind :: Lazy a -> (a -> Lazy b) -> Lazy b
+func Bind[T, U any](l *Lazy[T], fn func(T) *Lazy[U]) *Lazy[U] {
+	return New(func() U { return fn(l.Get()).Get() })
+}
+
+// ToOption evaluates the Lazy and wraps its value in a Some.
+//
+// Type signature:
+//
+//	ToOption :: Lazy a -> Option a
+//
+// If the computation panics, it returns None.
+func (l *Lazy[T]) ToOption() option.Option[T] {
+	return option.TryCatch(l.Get)
+}
+
+// ToResult evaluates the Lazy and wraps its value in an Ok.
+//
+// Type signature:
+//
+//	ToResult :: Lazy a -> Result a
+//
+// If the computation panics, it returns an Err holding a *result.PanicError.
+func (l *Lazy[T]) ToResult() result.Result[T] {
+	return result.TryCatch(l.Get)
+}
@@ -0,0 +1,150 @@
+package lazy
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// LazyResult represents a fallible computation that is deferred until its value is first needed, and then memoised.
+//
+// Type signature:
+//
+//	LazyResult[T] :: (() -> (a, error)) -> LazyResult a
+//
+// A LazyResult is safe for concurrent use. Concurrent callers wait for a single evaluation rather than running
+// the function in parallel.
+type LazyResult[T any] struct {
+	mu        sync.Mutex
+	fn        func() (T, error)
+	attempts  int
+	retry     bool
+	res       result.Result[T]
+	evaluated atomic.Bool
+}
+
+// NewResult creates a LazyResult that computes its value with fn when first needed.
+//
+// Type signature:
+//
+//	NewResult :: (() -> (a, error)) -> LazyResult a
+//
+// The outcome of fn is memoised whether it succeeds or fails.
+func NewResult[T any](fn func() (T, error)) *LazyResult[T] {
+	return &LazyResult[T]{fn: fn, attempts: 1}
+}
+
+// NewResultRetry creates a LazyResult that computes its value with fn when first needed, retrying on error.
+//
+// Type signature:
+//
+//	NewResultRetry :: (() -> (a, error)) -> Int -> LazyResult a
+//
+// Each evaluation calls fn up to attempts times, stopping at the first success. Only a success is memoised:
+// if every attempt fails, the last error is returned and the next call to Get evaluates again.
+func NewResultRetry[T any](fn func() (T, error), attempts int) *LazyResult[T] {
+	return &LazyResult[T]{fn: fn, attempts: max(attempts, 1), retry: true}
+}
+
+// FromResult creates a LazyResult that has already been evaluated to r.
+//
+// Type signature:
+//
+//	FromResult :: Result a -> LazyResult a
+func FromResult[T any](r result.Result[T]) *LazyResult[T] {
+	l := &LazyResult[T]{res: r}
+	l.evaluated.Store(true)
+	return l
+}
+
+// Get returns the outcome of the LazyResult, computing it if it has not been memoised yet.
+//
+// Type signature:
+//
+//	Get :: LazyResult a -> Result a
+func (l *LazyResult[T]) Get() result.Result[T] {
+	if l.evaluated.Load() {
+		return l.res
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.evaluated.Load() {
+		return l.res
+	}
+
+	var res result.Result[T]
+	for i := 0; i < l.attempts; i++ {
+		if res = result.Lift(l.fn); res.IsOk() {
+			break
+		}
+	}
+	if res.IsOk() || !l.retry {
+		l.res, l.fn = res, nil
+		l.evaluated.Store(true)
+	}
+	return res
+}
+
+// Unpack returns the value and error of the LazyResult, computing them if they have not been memoised yet.
+//
+// Type signature:
+//
+//	Unpack :: LazyResult a -> (a, error)
+func (l *LazyResult[T]) Unpack() (T, error) {
+	return l.Get().Unpack()
+}
+
+// IsEvaluated reports whether the outcome of the LazyResult has been memoised, without computing it.
+//
+// Type signature:
+//
+//	IsEvaluated :: LazyResult a -> Bool
+func (l *LazyResult[T]) IsEvaluated() bool {
+	return l.evaluated.Load()
+}
+
+// ToOption evaluates the LazyResult, returning Some if it succeeded and None otherwise.
+//
+// Type signature:
+//
+//	ToOption :: LazyResult a -> Option a
+func (l *LazyResult[T]) ToOption() option.Option[T] {
+	return l.Get().ToOption()
+}
+
+// ToResult evaluates the LazyResult. It is equivalent to Get.
+//
+// Type signature:
+//
+//	ToResult :: LazyResult a -> Result a
+func (l *LazyResult[T]) ToResult() result.Result[T] {
+	return l.Get()
+}
+
+// MapResult applies a function to the value of the LazyResult, deferring both until the new value is needed.
+//
+// Type signature:
+//
+//	MapResult :: LazyResult a -> (a -> b) -> LazyResult b
+//
+// An error is carried over unchanged. If the original LazyResult retries on error, so does the mapped one.
+func MapResult[T, U any](l *LazyResult[T], fn func(T) U) *LazyResult[U] {
+	return BindResult(l, func(val T) result.Result[U] { return result.Ok(fn(val)) })
+}
+
+// BindResult applies a fallible function to the value of the LazyResult, deferring both until the new value is needed.
+//
+// Type signature:
+//
+//	BindResult :: LazyResult a -> (a -> Result b) -> LazyResult b
+//
+// An error is carried over unchanged. If the original LazyResult retries on error, so does the bound one.
+func BindResult[T, U any](l *LazyResult[T], fn func(T) result.Result[U]) *LazyResult[U] {
+	return &LazyResult[U]{
+		fn:       func() (U, error) { return result.Bind(l.Get(), fn).Unpack() },
+		attempts: 1,
+		retry:    l.retry,
+	}
+}
@@ -0,0 +1,116 @@
+package lazy_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/lazy"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestLazyResultMemoisesErrors(t *testing.T) {
+	errTest := errors.New("test error")
+	var calls atomic.Int32
+	l := lazy.NewResult(func() (int, error) {
+		calls.Add(1)
+		return 0, errTest
+	})
+	for i := 0; i < 3; i++ {
+		if _, err := l.Unpack(); err != errTest {
+			t.Errorf("expected %v, got %v", errTest, err)
+		}
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected fn to run once, ran %d times", calls.Load())
+	}
+	if !l.IsEvaluated() {
+		t.Errorf("expected the error to be memoised")
+	}
+}
+
+func TestLazyResultRetry(t *testing.T) {
+	var calls atomic.Int32
+	l := lazy.NewResultRetry(func() (string, error) {
+		if calls.Add(1) < 5 {
+			return "", errors.New("not yet")
+		}
+		return "connected", nil
+	}, 3)
+
+	if _, err := l.Unpack(); err == nil {
+		t.Fatalf("expected the first evaluation to fail")
+	}
+	if calls.Load() != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls.Load())
+	}
+	if l.IsEvaluated() {
+		t.Errorf("expected a failure not to be memoised")
+	}
+
+	if val, err := l.Unpack(); err != nil || val != "connected" {
+		t.Errorf("expected the second evaluation to succeed, got %q, %v", val, err)
+	}
+	l.Get()
+	if calls.Load() != 5 {
+		t.Errorf("expected the success to be memoised, fn ran %d times", calls.Load())
+	}
+}
+
+func TestLazyResultConcurrent(t *testing.T) {
+	var calls atomic.Int32
+	l := lazy.NewResult(func() (int, error) {
+		calls.Add(1)
+		return 7, nil
+	})
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.Get()
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("expected fn to run once, ran %d times", calls.Load())
+	}
+}
+
+func TestLazyResultMapAndBind(t *testing.T) {
+	base := lazy.NewResult(func() (int, error) { return 2, nil })
+	mapped := lazy.MapResult(base, func(x int) int { return x * 10 })
+	bound := lazy.BindResult(mapped, func(x int) result.Result[int] {
+		if x > 10 {
+			return result.Err[int](errors.New("too big"))
+		}
+		return result.Ok(x)
+	})
+	if base.IsEvaluated() {
+		t.Fatalf("expected MapResult and BindResult to be deferred")
+	}
+	if got := mapped.Get(); !result.Equal(got, result.Ok(20)) {
+		t.Errorf("expected Ok(20), got %v", got)
+	}
+	if _, err := bound.Unpack(); err == nil || err.Error() != "too big" {
+		t.Errorf("expected \"too big\", got %v", err)
+	}
+}
+
+func TestLazyResultConversions(t *testing.T) {
+	ok := lazy.FromResult(result.Ok(1))
+	if !ok.IsEvaluated() {
+		t.Errorf("expected FromResult to be evaluated")
+	}
+	if opt := ok.ToOption(); opt.IsNone() || *opt.GetOrNil() != 1 {
+		t.Errorf("expected Some(1), got %v", opt)
+	}
+	if res := ok.ToResult(); !result.Equal(res, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", res)
+	}
+	failed := lazy.FromResult(result.Err[int](errors.New("failed")))
+	if opt := failed.ToOption(); opt.IsSome() {
+		t.Errorf("expected None, got %v", opt)
+	}
+}
@@ -0,0 +1,105 @@
+package lazy_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/lazy"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestLazyComputesOnce(t *testing.T) {
+	var calls atomic.Int32
+	l := lazy.New(func() int {
+		calls.Add(1)
+		return 42
+	})
+	if l.IsEvaluated() {
+		t.Fatalf("expected the Lazy not to be evaluated before Get")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l.Get(); got != 42 {
+				t.Errorf("expected 42, got %d", got)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Errorf("expected fn to run once, ran %d times", calls.Load())
+	}
+	if !l.IsEvaluated() {
+		t.Errorf("expected the Lazy to be evaluated after Get")
+	}
+}
+
+func TestLazyValue(t *testing.T) {
+	l := lazy.Value("ready")
+	if !l.IsEvaluated() || l.Get() != "ready" {
+		t.Errorf("expected an evaluated Lazy holding \"ready\"")
+	}
+}
+
+func TestLazyMapAndBind(t *testing.T) {
+	var calls atomic.Int32
+	base := lazy.New(func() int {
+		calls.Add(1)
+		return 2
+	})
+	doubled := base.Map(func(x int) int { return x * 2 })
+	str := lazy.Map(base, func(x int) string { return string(rune('a' + x)) })
+	bound := lazy.Bind(doubled, func(x int) *lazy.Lazy[int] { return lazy.Value(x + 1) })
+
+	if base.IsEvaluated() {
+		t.Fatalf("expected Map and Bind to be deferred")
+	}
+	if got := bound.Get(); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+	if got := str.Get(); got != "c" {
+		t.Errorf("expected \"c\", got %q", got)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected the base to be evaluated once, was evaluated %d times", calls.Load())
+	}
+}
+
+func TestLazyPanicsConsistently(t *testing.T) {
+	l := lazy.New(func() int { panic("boom") })
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r != "boom" {
+					t.Errorf("expected panic \"boom\", got %v", r)
+				}
+			}()
+			l.Get()
+		}()
+	}
+}
+
+func TestLazyConversions(t *testing.T) {
+	ok := lazy.Value(1)
+	if opt := ok.ToOption(); opt.IsNone() || *opt.GetOrNil() != 1 {
+		t.Errorf("expected Some(1), got %v", opt)
+	}
+	if res := ok.ToResult(); !result.Equal(res, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", res)
+	}
+
+	panicky := lazy.New(func() int { panic("boom") })
+	if opt := panicky.ToOption(); opt.IsSome() {
+		t.Errorf("expected None, got %v", opt)
+	}
+	var pe *result.PanicError
+	if _, err := panicky.ToResult().Unpack(); !errors.As(err, &pe) {
+		t.Errorf("expected a PanicError, got %v", err)
+	}
+}
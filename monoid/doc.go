@@ -0,0 +1,26 @@
+/*
+Package monoid provides Semigroup and Monoid abstractions, turning common aggregations into reusable values.
+
+A Semigroup combines two values of a type into one, associatively. A Monoid is a Semigroup with an empty value
+that leaves any value unchanged when combined with it, so a Monoid can reduce any number of values, including none.
+
+monoid consists of:
+
+	Semigroup[T]/Monoid[T]: The interfaces, and MakeSemigroup/Make to build them from functions.
+	Sum/Product/Min/Max/All/Any: Instances for numbers and booleans.
+	String/Slice/Map/Union: Instances for concatenation and union.
+	First/Last/Option/Result: Instances for the gonads monads.
+
+Usage Example:
+
+	type Order struct {
+	    Customer string
+	    Total    float64
+	}
+
+	totals := iters.AggregateMonoid(iters.GroupBy(orders, func(o Order) string { return o.Customer }),
+	    monoid.Sum[float64](), func(o Order) float64 { return o.Total })
+
+In this example, Sum provides both the starting value and the reducer, so no explicit init or fold function is needed.
+*/
+package monoid
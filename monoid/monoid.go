@@ -0,0 +1,248 @@
+package monoid
+
+import (
+	"cmp"
+
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Semigroup combines two values of a type into one.
+//
+// Type signature:
+//
+//	Semigroup[T] :: a -> a -> a
+//
+// Combine must be associative: Combine(Combine(a, b), c) equals Combine(a, Combine(b, c)).
+type Semigroup[T any] interface {
+	Combine(a, b T) T
+}
+
+// Monoid is a Semigroup with an identity value.
+//
+// Type signature:
+//
+//	Monoid[T] :: (a, a -> a -> a)
+//
+// Empty must return the identity of Combine: Combine(Empty(), a) and Combine(a, Empty()) both equal a.
+type Monoid[T any] interface {
+	Semigroup[T]
+	Empty() T
+}
+
+// Number is the set of types supported by Sum and Product.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 | ~complex64 | ~complex128
+}
+
+type semigroup[T any] func(a, b T) T
+
+func (s semigroup[T]) Combine(a, b T) T { return s(a, b) }
+
+type monoid[T any] struct {
+	semigroup[T]
+	empty func() T
+}
+
+func (m monoid[T]) Empty() T { return m.empty() }
+
+// MakeSemigroup creates a Semigroup from an associative combining function.
+//
+// Type signature:
+//
+//	MakeSemigroup :: (a -> a -> a) -> Semigroup a
+func MakeSemigroup[T any](combine func(a, b T) T) Semigroup[T] {
+	return semigroup[T](combine)
+}
+
+// Make creates a Monoid from an identity and an associative combining function.
+//
+// Type signature:
+//
+//	Make :: (() -> a) -> (a -> a -> a) -> Monoid a
+//
+// empty is called each time an identity is needed, so mutable identities such as maps are never shared.
+func Make[T any](empty func() T, combine func(a, b T) T) Monoid[T] {
+	return monoid[T]{semigroup: combine, empty: empty}
+}
+
+// Sum is the Monoid of numbers under addition, with identity 0.
+//
+// Type signature:
+//
+//	Sum :: Monoid a
+func Sum[T Number]() Monoid[T] {
+	return Make(func() T { return 0 }, func(a, b T) T { return a + b })
+}
+
+// Product is the Monoid of numbers under multiplication, with identity 1.
+//
+// Type signature:
+//
+//	Product :: Monoid a
+func Product[T Number]() Monoid[T] {
+	return Make(func() T { return 1 }, func(a, b T) T { return a * b })
+}
+
+// Min is the Semigroup of ordered values under min. Wrap it with Option to obtain a Monoid.
+//
+// Type signature:
+//
+//	Min :: Semigroup a
+func Min[T cmp.Ordered]() Semigroup[T] {
+	return MakeSemigroup(func(a, b T) T { return min(a, b) })
+}
+
+// Max is the Semigroup of ordered values under max. Wrap it with Option to obtain a Monoid.
+//
+// Type signature:
+//
+//	Max :: Semigroup a
+func Max[T cmp.Ordered]() Semigroup[T] {
+	return MakeSemigroup(func(a, b T) T { return max(a, b) })
+}
+
+// All is the Monoid of booleans under conjunction, with identity true.
+//
+// Type signature:
+//
+//	All :: Monoid Bool
+func All() Monoid[bool] {
+	return Make(func() bool { return true }, func(a, b bool) bool { return a && b })
+}
+
+// Any is the Monoid of booleans under disjunction, with identity false.
+//
+// Type signature:
+//
+//	Any :: Monoid Bool
+func Any() Monoid[bool] {
+	return Make(func() bool { return false }, func(a, b bool) bool { return a || b })
+}
+
+// String is the Monoid of strings under concatenation, with identity "".
+//
+// Type signature:
+//
+//	String :: Monoid String
+func String() Monoid[string] {
+	return Make(func() string { return "" }, func(a, b string) string { return a + b })
+}
+
+// Slice is the Monoid of slices under concatenation, with identity nil.
+//
+// Type signature:
+//
+//	Slice :: Monoid [a]
+//
+// Combine always returns a new slice, so its arguments are never modified or aliased.
+func Slice[T any]() Monoid[[]T] {
+	return Make(func() []T { return nil }, func(a, b []T) []T {
+		if len(a)+len(b) == 0 {
+			return nil
+		}
+		out := make([]T, 0, len(a)+len(b))
+		return append(append(out, a...), b...)
+	})
+}
+
+// Map is the Monoid of maps under union, with identity the empty map.
+//
+// Type signature:
+//
+//	Map :: Semigroup v -> Monoid (Map k v)
+//
+// Values present under the same key in both maps are combined with values.
+// Combine always returns a new map, so its arguments are never modified.
+func Map[K comparable, V any](values Semigroup[V]) Monoid[map[K]V] {
+	return Make(func() map[K]V { return map[K]V{} }, func(a, b map[K]V) map[K]V {
+		out := make(map[K]V, max(len(a), len(b)))
+		for k, v := range a {
+			out[k] = v
+		}
+		for k, v := range b {
+			if existing, ok := out[k]; ok {
+				v = values.Combine(existing, v)
+			}
+			out[k] = v
+		}
+		return out
+	})
+}
+
+// Union is the Monoid of sets under union, with identity the empty set.
+//
+// Type signature:
+//
+//	Union :: Monoid (Set k)
+func Union[K comparable]() Monoid[map[K]struct{}] {
+	return Map[K](MakeSemigroup(func(a, _ struct{}) struct{} { return a }))
+}
+
+// First is the Monoid of Options that keeps the first Some, with identity None.
+//
+// Type signature:
+//
+//	First :: Monoid (Option a)
+func First[T any]() Monoid[option.Option[T]] {
+	return Make(option.None[T], func(a, b option.Option[T]) option.Option[T] {
+		if a.IsSome() {
+			return a
+		}
+		return b
+	})
+}
+
+// Last is the Monoid of Options that keeps the last Some, with identity None.
+//
+// Type signature:
+//
+//	Last :: Monoid (Option a)
+func Last[T any]() Monoid[option.Option[T]] {
+	return Make(option.None[T], func(a, b option.Option[T]) option.Option[T] {
+		if b.IsSome() {
+			return b
+		}
+		return a
+	})
+}
+
+// Option lifts a Semigroup into the Monoid of Options, with identity None.
+//
+// Type signature:
+//
+//	Option :: Semigroup a -> Monoid (Option a)
+//
+// Two Somes are combined with s; None is skipped.
+func Option[T any](s Semigroup[T]) Monoid[option.Option[T]] {
+	return Make(option.None[T], func(a, b option.Option[T]) option.Option[T] {
+		switch {
+		case a.IsNone():
+			return b
+		case b.IsNone():
+			return a
+		}
+		return option.Map2(a, b, s.Combine)
+	})
+}
+
+// Result lifts a Monoid into the Monoid of Results, with identity Ok(m.Empty()).
+//
+// Type signature:
+//
+//	Result :: Monoid a -> Monoid (Result a)
+//
+// Two Oks are combined with m. Otherwise, the first Err is kept.
+func Result[T any](m Monoid[T]) Monoid[result.Result[T]] {
+	return Make(func() result.Result[T] { return result.Ok(m.Empty()) }, func(a, b result.Result[T]) result.Result[T] {
+		switch {
+		case a.IsErr():
+			return a
+		case b.IsErr():
+			return b
+		}
+		return result.Ok(m.Combine(a.Must(), b.Must()))
+	})
+}
@@ -0,0 +1,126 @@
+package monoid_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/monoid"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// checkLaws verifies associativity and identity for every combination of the samples.
+func checkLaws[T any](t *testing.T, m monoid.Monoid[T], samples ...T) {
+	t.Helper()
+	for _, a := range samples {
+		if got := m.Combine(m.Empty(), a); !reflect.DeepEqual(got, a) {
+			t.Errorf("left identity: Combine(Empty(), %v) = %v", a, got)
+		}
+		if got := m.Combine(a, m.Empty()); !reflect.DeepEqual(got, a) {
+			t.Errorf("right identity: Combine(%v, Empty()) = %v", a, got)
+		}
+		for _, b := range samples {
+			for _, c := range samples {
+				left := m.Combine(m.Combine(a, b), c)
+				right := m.Combine(a, m.Combine(b, c))
+				if !reflect.DeepEqual(left, right) {
+					t.Errorf("associativity: (%v <> %v) <> %v = %v, but %v <> (%v <> %v) = %v", a, b, c, left, a, b, c, right)
+				}
+			}
+		}
+	}
+}
+
+func TestNumbers(t *testing.T) {
+	checkLaws(t, monoid.Sum[int](), -2, 0, 3)
+	checkLaws(t, monoid.Product[float64](), 0.5, 1, 4)
+	if got := monoid.Sum[int]().Combine(2, 3); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+	if got := monoid.Product[int]().Combine(2, 3); got != 6 {
+		t.Errorf("expected 6, got %d", got)
+	}
+	if got := monoid.Min[int]().Combine(2, 3); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+	if got := monoid.Max[string]().Combine("a", "b"); got != "b" {
+		t.Errorf("expected \"b\", got %q", got)
+	}
+}
+
+func TestBooleans(t *testing.T) {
+	checkLaws(t, monoid.All(), true, false)
+	checkLaws(t, monoid.Any(), true, false)
+	if monoid.All().Combine(true, false) || !monoid.Any().Combine(true, false) {
+		t.Errorf("expected All to be && and Any to be ||")
+	}
+}
+
+func TestStringAndSlice(t *testing.T) {
+	checkLaws(t, monoid.String(), "", "a", "bc")
+	checkLaws(t, monoid.Slice[int](), nil, []int{1}, []int{2, 3})
+
+	a := make([]int, 1, 4)
+	b := []int{2}
+	got := monoid.Slice[int]().Combine(a, b)
+	got[0] = 9
+	if a[0] != 0 {
+		t.Errorf("expected Combine not to alias its arguments")
+	}
+}
+
+func TestMapAndUnion(t *testing.T) {
+	counts := monoid.Map[string](monoid.Sum[int]())
+	checkLaws(t, counts, map[string]int{}, map[string]int{"a": 1}, map[string]int{"a": 2, "b": 3})
+
+	got := counts.Combine(map[string]int{"a": 1}, map[string]int{"a": 2, "b": 3})
+	if want := map[string]int{"a": 3, "b": 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	set := monoid.Union[int]()
+	got2 := set.Combine(map[int]struct{}{1: {}}, map[int]struct{}{2: {}})
+	if len(got2) != 2 {
+		t.Errorf("expected the union of both sets, got %v", got2)
+	}
+}
+
+func TestOption(t *testing.T) {
+	some1, some2, none := option.Some(1), option.Some(2), option.None[int]()
+	checkLaws(t, monoid.First[int](), some1, some2, none)
+	checkLaws(t, monoid.Last[int](), some1, some2, none)
+	checkLaws(t, monoid.Option(monoid.Sum[int]()), some1, some2, none)
+	checkLaws(t, monoid.Option(monoid.Min[int]()), some1, some2, none)
+
+	if got := monoid.First[int]().Combine(none, some2); !option.Equal(got, some2) {
+		t.Errorf("expected First to skip None, got %v", got)
+	}
+	if got := monoid.Last[int]().Combine(some1, some2); !option.Equal(got, some2) {
+		t.Errorf("expected Some(2), got %v", got)
+	}
+	if got := monoid.Option(monoid.Sum[int]()).Combine(some1, some2); !option.Equal(got, option.Some(3)) {
+		t.Errorf("expected Some(3), got %v", got)
+	}
+}
+
+func TestResult(t *testing.T) {
+	errA := errors.New("a")
+	errB := errors.New("b")
+	m := monoid.Result(monoid.Sum[int]())
+	checkLaws(t, m, result.Ok(1), result.Ok(2), result.Err[int](errA), result.Err[int](errB))
+
+	if got := m.Combine(result.Ok(1), result.Ok(2)); !result.Equal(got, result.Ok(3)) {
+		t.Errorf("expected Ok(3), got %v", got)
+	}
+	if _, err := m.Combine(result.Err[int](errA), result.Err[int](errB)).Unpack(); err != errA {
+		t.Errorf("expected the first error, got %v", err)
+	}
+}
+
+func TestMake(t *testing.T) {
+	m := monoid.Make(func() []string { return []string{} }, func(a, b []string) []string {
+		return append(append([]string{}, a...), b...)
+	})
+	checkLaws(t, m, []string{}, []string{"x"}, []string{"y", "z"})
+}
@@ -0,0 +1,31 @@
+/*
+Package nonempty provides NonEmpty, a slice type that is guaranteed to hold at least one element.
+
+Operations such as taking the first element, reducing or finding a maximum are partial on ordinary slices,
+because the slice might be empty. A NonEmpty rules that out in its type, so those operations return a plain T
+rather than an Option, and no emptiness check can be forgotten.
+
+NonEmpty consists of:
+
+	New/FromIter: Create a NonEmpty from at least one element, or from a slice that might be empty.
+	Head/Last/Reduce/Max/Min: Total operations that always return a value.
+	Map/FlatMap/Append: Operations that preserve non-emptiness.
+	GroupBy: Groups a slice into a Grouping whose groups are each NonEmpty.
+
+Usage Example:
+
+	func validate(u User) option.Option[nonempty.NonEmpty[error]] {
+	    var errs []error
+	    if u.Name == "" {
+	        errs = append(errs, errors.New("name is required"))
+	    }
+	    if u.Age < 0 {
+	        errs = append(errs, errors.New("age must not be negative"))
+	    }
+	    return nonempty.FromIter(errs)
+	}
+
+In this example, a Some always holds at least one error, so callers can report validation failures without
+checking for an empty list.
+*/
+package nonempty
@@ -0,0 +1,277 @@
+package nonempty
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/monoid"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// NonEmpty represents a slice that holds at least one element.
+//
+// Type signature:
+//
+//	NonEmpty[T] :: (a, [a])
+//
+// The zero value holds a single zero element.
+type NonEmpty[T any] struct {
+	head T
+	tail []T
+}
+
+// New creates a NonEmpty from its first element and any further elements.
+//
+// Type signature:
+//
+//	New :: a -> [a] -> NonEmpty a
+func New[T any](head T, tail ...T) NonEmpty[T] {
+	return NonEmpty[T]{head: head, tail: append([]T(nil), tail...)}
+}
+
+// FromIter creates a NonEmpty from a slice, if the slice is not empty.
+//
+// Type signature:
+//
+//	FromIter :: Iter a -> Option (NonEmpty a)
+//
+// It returns Some holding a copy of the slice, or None if the slice is empty.
+func FromIter[T any](s iters.Iter[T]) option.Option[NonEmpty[T]] {
+	if len(s) == 0 {
+		return option.None[NonEmpty[T]]()
+	}
+	return option.Some(New(s[0], s[1:]...))
+}
+
+// Head returns the first element.
+//
+// Type signature:
+//
+//	Head :: NonEmpty a -> a
+func (n NonEmpty[T]) Head() T {
+	return n.head
+}
+
+// Tail returns every element after the first, which may be none.
+//
+// Type signature:
+//
+//	Tail :: NonEmpty a -> Iter a
+func (n NonEmpty[T]) Tail() iters.Iter[T] {
+	return append(iters.Iter[T](nil), n.tail...)
+}
+
+// Last returns the last element.
+//
+// Type signature:
+//
+//	Last :: NonEmpty a -> a
+func (n NonEmpty[T]) Last() T {
+	if len(n.tail) == 0 {
+		return n.head
+	}
+	return n.tail[len(n.tail)-1]
+}
+
+// Len returns the number of elements, which is always at least one.
+//
+// Type signature:
+//
+//	Len :: NonEmpty a -> Int
+func (n NonEmpty[T]) Len() int {
+	return 1 + len(n.tail)
+}
+
+// ToIter returns the elements as an Iter.
+//
+// Type signature:
+//
+//	ToIter :: NonEmpty a -> Iter a
+func (n NonEmpty[T]) ToIter() iters.Iter[T] {
+	return append(iters.Iter[T]{n.head}, n.tail...)
+}
+
+// Append returns a NonEmpty with vals added to the end.
+//
+// Type signature:
+//
+//	Append :: NonEmpty a -> [a] -> NonEmpty a
+func (n NonEmpty[T]) Append(vals ...T) NonEmpty[T] {
+	tail := make([]T, 0, len(n.tail)+len(vals))
+	return NonEmpty[T]{head: n.head, tail: append(append(tail, n.tail...), vals...)}
+}
+
+// Reduce combines the elements from left to right, starting from the first.
+//
+// Type signature:
+//
+//	Reduce :: NonEmpty a -> (a -> a -> a) -> a
+func (n NonEmpty[T]) Reduce(f func(T, T) T) T {
+	acc := n.head
+	for _, v := range n.tail {
+		acc = f(acc, v)
+	}
+	return acc
+}
+
+// Concat combines the elements with a semigroup, from left to right.
+//
+// Type signature:
+//
+//	Concat :: NonEmpty a -> Semigroup a -> a
+func (n NonEmpty[T]) Concat(s monoid.Semigroup[T]) T {
+	return n.Reduce(s.Combine)
+}
+
+// MaxFunc returns the greatest element under compare. If several are greatest, the first is returned.
+//
+// Type signature:
+//
+//	MaxFunc :: NonEmpty a -> (a -> a -> Int) -> a
+func (n NonEmpty[T]) MaxFunc(compare func(a, b T) int) T {
+	return n.Reduce(func(acc, v T) T {
+		if compare(v, acc) > 0 {
+			return v
+		}
+		return acc
+	})
+}
+
+// MinFunc returns the least element under compare. If several are least, the first is returned.
+//
+// Type signature:
+//
+//	MinFunc :: NonEmpty a -> (a -> a -> Int) -> a
+func (n NonEmpty[T]) MinFunc(compare func(a, b T) int) T {
+	return n.Reduce(func(acc, v T) T {
+		if compare(v, acc) < 0 {
+			return v
+		}
+		return acc
+	})
+}
+
+// Max returns the greatest element.
+//
+// Type signature:
+//
+//	Max :: NonEmpty a -> a
+func Max[T cmp.Ordered](n NonEmpty[T]) T {
+	return n.MaxFunc(cmp.Compare[T])
+}
+
+// Min returns the least element.
+//
+// Type signature:
+//
+//	Min :: NonEmpty a -> a
+func Min[T cmp.Ordered](n NonEmpty[T]) T {
+	return n.MinFunc(cmp.Compare[T])
+}
+
+// Map applies a function to each element.
+//
+// Type signature:
+//
+//	Map :: NonEmpty a -> (a -> a) -> NonEmpty a
+func (n NonEmpty[T]) Map(f func(T) T) NonEmpty[T] {
+	return Map(n, f)
+}
+
+// Map applies a function to each element, preserving non-emptiness.
+//
+// Type signature:
+//
+//	Map :: NonEmpty a -> (a -> b) -> NonEmpty b
+func Map[T, U any](n NonEmpty[T], f func(T) U) NonEmpty[U] {
+	tail := make([]U, len(n.tail))
+	for i, v := range n.tail {
+		tail[i] = f(v)
+	}
+	return NonEmpty[U]{head: f(n.head), tail: tail}
+}
+
+// FlatMap applies a function returning a NonEmpty to each element and concatenates the results,
+// preserving non-emptiness.
+//
+// Type signature:
+//
+//	FlatMap :: NonEmpty a -> (a -> NonEmpty b) -> NonEmpty b
+func FlatMap[T, U any](n NonEmpty[T], f func(T) NonEmpty[U]) NonEmpty[U] {
+	first := f(n.head)
+	tail := append([]U(nil), first.tail...)
+	for _, v := range n.tail {
+		next := f(v)
+		tail = append(tail, next.head)
+		tail = append(tail, next.tail...)
+	}
+	return NonEmpty[U]{head: first.head, tail: tail}
+}
+
+// Grouping maps keys to groups that each hold at least one element.
+type Grouping[K comparable, T any] map[K]NonEmpty[T]
+
+// GroupBy groups elements of a slice into a map keyed by the output of the key function f.
+//
+// Type signature:
+//
+//	GroupBy :: Iter T -> (T -> K) -> Grouping K (NonEmpty T)
+//
+// Every group in the returned Grouping holds at least one element, in the order they appear in the slice.
+func GroupBy[T any, K comparable](s iters.Iter[T], f func(T) K) Grouping[K, T] {
+	groups := make(Grouping[K, T])
+	for _, v := range s {
+		key := f(v)
+		if group, ok := groups[key]; ok {
+			group.tail = append(group.tail, v)
+			groups[key] = group
+		} else {
+			groups[key] = NonEmpty[T]{head: v}
+		}
+	}
+	return groups
+}
+
+// ToGrouping converts the Grouping into an iters.Grouping, for use with iters.Aggregate.
+//
+// Type signature:
+//
+//	ToGrouping :: Grouping K (NonEmpty T) -> Grouping K T
+func (g Grouping[K, T]) ToGrouping() iters.Grouping[K, T] {
+	out := make(iters.Grouping[K, T], len(g))
+	for k, group := range g {
+		out[k] = group.ToIter()
+	}
+	return out
+}
+
+// JoinErrors combines a NonEmpty of errors into a single error with errors.Join.
+//
+// Type signature:
+//
+//	JoinErrors :: NonEmpty error -> error
+//
+// The result is never nil unless every error in n is nil.
+func JoinErrors(n NonEmpty[error]) error {
+	return errors.Join(n.ToIter()...)
+}
+
+// MarshalJSON encodes the NonEmpty as a JSON array.
+func (n NonEmpty[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.ToIter().ToSlice())
+}
+
+// UnmarshalJSON decodes a JSON array into the NonEmpty, rejecting an empty array.
+func (n *NonEmpty[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return errors.New("nonempty: cannot decode an empty array")
+	}
+	*n = New(items[0], items[1:]...)
+	return nil
+}
@@ -0,0 +1,155 @@
+package nonempty_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/monoid"
+	"github.com/alsi-lawr/gonads/nonempty"
+)
+
+func TestFromIter(t *testing.T) {
+	if got := nonempty.FromIter([]int{}); got.IsSome() {
+		t.Errorf("expected None for an empty slice, got %v", got)
+	}
+	s := []int{1, 2, 3}
+	opt := nonempty.FromIter(s)
+	if opt.IsNone() {
+		t.Fatalf("expected Some for a non-empty slice")
+	}
+	n := *opt.GetOrNil()
+	s[0] = 9
+	if n.Head() != 1 {
+		t.Errorf("expected FromIter to copy the slice")
+	}
+}
+
+func TestAccessors(t *testing.T) {
+	n := nonempty.New(1, 2, 3)
+	if n.Head() != 1 || n.Last() != 3 || n.Len() != 3 {
+		t.Errorf("expected head 1, last 3, len 3, got %d, %d, %d", n.Head(), n.Last(), n.Len())
+	}
+	if want := (iters.Iter[int]{2, 3}); !reflect.DeepEqual(n.Tail(), want) {
+		t.Errorf("expected tail %v, got %v", want, n.Tail())
+	}
+	if want := (iters.Iter[int]{1, 2, 3}); !reflect.DeepEqual(n.ToIter(), want) {
+		t.Errorf("expected %v, got %v", want, n.ToIter())
+	}
+
+	single := nonempty.New("only")
+	if single.Head() != "only" || single.Last() != "only" || single.Len() != 1 {
+		t.Errorf("expected a single element")
+	}
+
+	var zero nonempty.NonEmpty[int]
+	if zero.Len() != 1 || zero.Head() != 0 {
+		t.Errorf("expected the zero value to hold a single zero element")
+	}
+}
+
+func TestAppendDoesNotAlias(t *testing.T) {
+	base := nonempty.New(1, 2)
+	a := base.Append(3)
+	b := base.Append(4)
+	if a.Last() != 3 || b.Last() != 4 || base.Len() != 2 {
+		t.Errorf("expected Append to leave the original unchanged, got %v and %v", a.ToIter(), b.ToIter())
+	}
+}
+
+func TestReductions(t *testing.T) {
+	n := nonempty.New(3, 1, 4, 1, 5)
+	if got := n.Reduce(func(a, b int) int { return a + b }); got != 14 {
+		t.Errorf("expected 14, got %d", got)
+	}
+	if got := n.Concat(monoid.Product[int]()); got != 60 {
+		t.Errorf("expected 60, got %d", got)
+	}
+	if nonempty.Max(n) != 5 || nonempty.Min(n) != 1 {
+		t.Errorf("expected max 5 and min 1, got %d and %d", nonempty.Max(n), nonempty.Min(n))
+	}
+
+	words := nonempty.New("bb", "a", "cc")
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	if got := words.MaxFunc(byLen); got != "bb" {
+		t.Errorf("expected the first longest word, got %q", got)
+	}
+	if got := words.MinFunc(byLen); got != "a" {
+		t.Errorf("expected \"a\", got %q", got)
+	}
+}
+
+func TestMapAndFlatMap(t *testing.T) {
+	n := nonempty.New(1, 2)
+	doubled := n.Map(func(x int) int { return x * 2 })
+	if want := (iters.Iter[int]{2, 4}); !reflect.DeepEqual(doubled.ToIter(), want) {
+		t.Errorf("expected %v, got %v", want, doubled.ToIter())
+	}
+	strs := nonempty.Map(n, func(x int) string { return strings.Repeat("x", x) })
+	if strs.Last() != "xx" {
+		t.Errorf("expected \"xx\", got %q", strs.Last())
+	}
+	flat := nonempty.FlatMap(n, func(x int) nonempty.NonEmpty[int] { return nonempty.New(x, x*10) })
+	if want := (iters.Iter[int]{1, 10, 2, 20}); !reflect.DeepEqual(flat.ToIter(), want) {
+		t.Errorf("expected %v, got %v", want, flat.ToIter())
+	}
+	shared := nonempty.New(7, make([]int, 1, 8)...)
+	nonempty.FlatMap(n, func(int) nonempty.NonEmpty[int] { return shared })
+	if want := (iters.Iter[int]{7, 0}); !reflect.DeepEqual(shared.ToIter(), want) {
+		t.Errorf("expected FlatMap to leave its inputs unchanged, got %v", shared.ToIter())
+	}
+}
+
+func TestGroupBy(t *testing.T) {
+	g := nonempty.GroupBy(iters.Iter[string]{"apple", "avocado", "banana"}, func(s string) byte { return s[0] })
+	if len(g) != 2 {
+		t.Fatalf("expected 2 groups, got %d", len(g))
+	}
+	if g['a'].Head() != "apple" || g['a'].Last() != "avocado" || g['b'].Len() != 1 {
+		t.Errorf("unexpected groups: %v", g.ToGrouping())
+	}
+	want := iters.Grouping[byte, string]{'a': {"apple", "avocado"}, 'b': {"banana"}}
+	if got := g.ToGrouping(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestValidationErrors(t *testing.T) {
+	validate := func(name string, age int) error {
+		var errs []error
+		if name == "" {
+			errs = append(errs, errors.New("name is required"))
+		}
+		if age < 0 {
+			errs = append(errs, errors.New("age must not be negative"))
+		}
+		opt := nonempty.FromIter(errs)
+		if opt.IsNone() {
+			return nil
+		}
+		return nonempty.JoinErrors(*opt.GetOrNil())
+	}
+	if err := validate("ada", 36); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := validate("", -1); err == nil || err.Error() != "name is required\nage must not be negative" {
+		t.Errorf("expected both errors, got %v", err)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	data, err := json.Marshal(nonempty.New(1, 2))
+	if err != nil || string(data) != "[1,2]" {
+		t.Errorf("expected [1,2], got %s, %v", data, err)
+	}
+	var n nonempty.NonEmpty[int]
+	if err := json.Unmarshal([]byte("[3,4,5]"), &n); err != nil || n.Len() != 3 || n.Last() != 5 {
+		t.Errorf("expected [3 4 5], got %v, %v", n.ToIter(), err)
+	}
+	if err := json.Unmarshal([]byte("[]"), &n); err == nil {
+		t.Errorf("expected an error for an empty array")
+	}
+}
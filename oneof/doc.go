@@ -0,0 +1,28 @@
+/*
+Package oneof provides n-ary sum types, which generalise Either to between three and eight cases.
+
+A OneOfN value holds exactly one of its N cases. Nesting Either values, as in Either[A, Either[B, C]],
+makes matching hard to read; a OneOf3[A, B, C] instead exposes every case at the same level.
+
+OneOfN consists of:
+
+	CaseKOfN: Constructs a value holding the Kth case.
+	IsK/AsK: Checks for, and extracts as an Option, the Kth case.
+	Match/FoldN: Exhaustively handles every case, with one handler per case.
+	MapKOfN: Transforms the Kth case, carrying any other case over unchanged.
+
+Usage Example:
+
+	type Shape = oneof.OneOf3[Circle, Square, Triangle]
+
+	func area(s Shape) float64 {
+	    return oneof.Fold3(s,
+	        func(c Circle) float64 { return math.Pi * c.R * c.R },
+	        func(s Square) float64 { return s.Side * s.Side },
+	        func(t Triangle) float64 { return t.Base * t.Height / 2 },
+	    )
+	}
+
+In this example, Fold3 requires a handler for each of the three shapes, so no case can be forgotten.
+*/
+package oneof
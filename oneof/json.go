@@ -0,0 +1,23 @@
+package oneof
+
+import "encoding/json"
+
+type taggedJSON struct {
+	Tag   string          `json:"tag"`
+	Value json.RawMessage `json:"value"`
+}
+
+func marshalTagged(tag string, v any) ([]byte, error) {
+	value, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(taggedJSON{Tag: tag, Value: value})
+}
+
+// unmarshalTagged decodes a tagged value, returning nil for a JSON null.
+func unmarshalTagged(data []byte) (*taggedJSON, error) {
+	var tagged *taggedJSON
+	err := json.Unmarshal(data, &tagged)
+	return tagged, err
+}
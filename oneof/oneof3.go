@@ -0,0 +1,254 @@
+package oneof
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// OneOf3 represents a value that is exactly one of three cases.
+//
+// Type signature:
+//
+//	OneOf3[T1, T2, T3] :: T1 | T2 | T3 -> OneOf3 T1 T2 T3
+//
+// The zero value holds the zero value of the first case.
+type OneOf3[T1, T2, T3 any] struct {
+	index uint8
+	v1    T1
+	v2    T2
+	v3    T3
+}
+
+// Case1Of3 creates a OneOf3 holding a value of the first case.
+//
+// Type signature:
+//
+//	Case1Of3 :: T1 -> OneOf3 T1 T2 T3
+func Case1Of3[T1, T2, T3 any](v T1) OneOf3[T1, T2, T3] {
+	return OneOf3[T1, T2, T3]{index: 0, v1: v}
+}
+
+// Case2Of3 creates a OneOf3 holding a value of the second case.
+//
+// Type signature:
+//
+//	Case2Of3 :: T2 -> OneOf3 T1 T2 T3
+func Case2Of3[T1, T2, T3 any](v T2) OneOf3[T1, T2, T3] {
+	return OneOf3[T1, T2, T3]{index: 1, v2: v}
+}
+
+// Case3Of3 creates a OneOf3 holding a value of the third case.
+//
+// Type signature:
+//
+//	Case3Of3 :: T3 -> OneOf3 T1 T2 T3
+func Case3Of3[T1, T2, T3 any](v T3) OneOf3[T1, T2, T3] {
+	return OneOf3[T1, T2, T3]{index: 2, v3: v}
+}
+
+// Index returns the number of the case held by the OneOf3, counting from 1.
+//
+// Type signature:
+//
+//	Index :: OneOf3 T1 T2 T3 -> Int
+func (o OneOf3[T1, T2, T3]) Index() int {
+	return int(o.index) + 1
+}
+
+// Is1 returns true if the OneOf3 holds a value of the first case.
+//
+// Type signature:
+//
+//	Is1 :: OneOf3 T1 T2 T3 -> Bool
+func (o OneOf3[T1, T2, T3]) Is1() bool {
+	return o.index == 0
+}
+
+// As1 returns the value of the first case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	As1 :: OneOf3 T1 T2 T3 -> Option T1
+//
+// It returns Some with the value if the OneOf3 holds the first case, or None otherwise.
+func (o OneOf3[T1, T2, T3]) As1() option.Option[T1] {
+	if o.index == 0 {
+		return option.Some(o.v1)
+	}
+	return option.None[T1]()
+}
+
+// Is2 returns true if the OneOf3 holds a value of the second case.
+//
+// Type signature:
+//
+//	Is2 :: OneOf3 T1 T2 T3 -> Bool
+func (o OneOf3[T1, T2, T3]) Is2() bool {
+	return o.index == 1
+}
+
+// As2 returns the value of the second case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	As2 :: OneOf3 T1 T2 T3 -> Option T2
+//
+// It returns Some with the value if the OneOf3 holds the second case, or None otherwise.
+func (o OneOf3[T1, T2, T3]) As2() option.Option[T2] {
+	if o.index == 1 {
+		return option.Some(o.v2)
+	}
+	return option.None[T2]()
+}
+
+// Is3 returns true if the OneOf3 holds a value of the third case.
+//
+// Type signature:
+//
+//	Is3 :: OneOf3 T1 T2 T3 -> Bool
+func (o OneOf3[T1, T2, T3]) Is3() bool {
+	return o.index == 2
+}
+
+// As3 returns the value of the third case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	As3 :: OneOf3 T1 T2 T3 -> Option T3
+//
+// It returns Some with the value if the OneOf3 holds the third case, or None otherwise.
+func (o OneOf3[T1, T2, T3]) As3() option.Option[T3] {
+	if o.index == 2 {
+		return option.Some(o.v3)
+	}
+	return option.None[T3]()
+}
+
+// Match applies the handler for the case held by the OneOf3.
+//
+// Type signature:
+//
+//	Match :: OneOf3 T1 T2 T3 -> (T1 -> ()) -> (T2 -> ()) -> (T3 -> ()) -> ()
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the match is exhaustive.
+func (o OneOf3[T1, T2, T3]) Match(f1 func(T1), f2 func(T2), f3 func(T3)) {
+	switch o.index {
+	case 1:
+		f2(o.v2)
+	case 2:
+		f3(o.v3)
+	default:
+		f1(o.v1)
+	}
+}
+
+// Fold3 collapses a OneOf3 into a single value by applying the handler for the case it holds.
+//
+// Type signature:
+//
+//	Fold3 :: OneOf3 T1 T2 T3 -> (T1 -> U) -> (T2 -> U) -> (T3 -> U) -> U
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the fold is exhaustive.
+func Fold3[T1, T2, T3, U any](o OneOf3[T1, T2, T3], f1 func(T1) U, f2 func(T2) U, f3 func(T3) U) U {
+	switch o.index {
+	case 1:
+		return f2(o.v2)
+	case 2:
+		return f3(o.v3)
+	default:
+		return f1(o.v1)
+	}
+}
+
+// Map1Of3 applies a function to the value of the first case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	Map1Of3 :: OneOf3 T1 T2 T3 -> (T1 -> U) -> OneOf3 U T2 T3
+//
+// If the OneOf3 holds any other case, that value is carried over unchanged.
+func Map1Of3[T1, T2, T3, U any](o OneOf3[T1, T2, T3], fn func(T1) U) OneOf3[U, T2, T3] {
+	if o.index == 0 {
+		return OneOf3[U, T2, T3]{index: o.index, v1: fn(o.v1)}
+	}
+	return OneOf3[U, T2, T3]{index: o.index, v2: o.v2, v3: o.v3}
+}
+
+// Map2Of3 applies a function to the value of the second case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	Map2Of3 :: OneOf3 T1 T2 T3 -> (T2 -> U) -> OneOf3 T1 U T3
+//
+// If the OneOf3 holds any other case, that value is carried over unchanged.
+func Map2Of3[T1, T2, T3, U any](o OneOf3[T1, T2, T3], fn func(T2) U) OneOf3[T1, U, T3] {
+	if o.index == 1 {
+		return OneOf3[T1, U, T3]{index: o.index, v2: fn(o.v2)}
+	}
+	return OneOf3[T1, U, T3]{index: o.index, v1: o.v1, v3: o.v3}
+}
+
+// Map3Of3 applies a function to the value of the third case, if the OneOf3 holds it.
+//
+// Type signature:
+//
+//	Map3Of3 :: OneOf3 T1 T2 T3 -> (T3 -> U) -> OneOf3 T1 T2 U
+//
+// If the OneOf3 holds any other case, that value is carried over unchanged.
+func Map3Of3[T1, T2, T3, U any](o OneOf3[T1, T2, T3], fn func(T3) U) OneOf3[T1, T2, U] {
+	if o.index == 2 {
+		return OneOf3[T1, T2, U]{index: o.index, v3: fn(o.v3)}
+	}
+	return OneOf3[T1, T2, U]{index: o.index, v1: o.v1, v2: o.v2}
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The value is encoded as an object with a "tag" discriminator naming the case, from "case1" onwards,
+// and a "value" holding the encoded value of that case, such as {"tag":"case2","value":"x"}.
+func (o OneOf3[T1, T2, T3]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return marshalTagged("case2", o.v2)
+	case 2:
+		return marshalTagged("case3", o.v3)
+	default:
+		return marshalTagged("case1", o.v1)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is not one of "case1" to "case3".
+// As with encoding/json, a JSON null leaves the OneOf3 unchanged.
+func (o *OneOf3[T1, T2, T3]) UnmarshalJSON(data []byte) error {
+	tagged, err := unmarshalTagged(data)
+	if err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "case1":
+		var v T1
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case1Of3[T1, T2, T3](v)
+	case "case2":
+		var v T2
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case2Of3[T1, T2, T3](v)
+	case "case3":
+		var v T3
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case3Of3[T1, T2, T3](v)
+	default:
+		return fmt.Errorf("oneof: unknown tag %q for OneOf3", tagged.Tag)
+	}
+	return nil
+}
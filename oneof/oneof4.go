@@ -0,0 +1,313 @@
+package oneof
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// OneOf4 represents a value that is exactly one of four cases.
+//
+// Type signature:
+//
+//	OneOf4[T1, T2, T3, T4] :: T1 | T2 | T3 | T4 -> OneOf4 T1 T2 T3 T4
+//
+// The zero value holds the zero value of the first case.
+type OneOf4[T1, T2, T3, T4 any] struct {
+	index uint8
+	v1    T1
+	v2    T2
+	v3    T3
+	v4    T4
+}
+
+// Case1Of4 creates a OneOf4 holding a value of the first case.
+//
+// Type signature:
+//
+//	Case1Of4 :: T1 -> OneOf4 T1 T2 T3 T4
+func Case1Of4[T1, T2, T3, T4 any](v T1) OneOf4[T1, T2, T3, T4] {
+	return OneOf4[T1, T2, T3, T4]{index: 0, v1: v}
+}
+
+// Case2Of4 creates a OneOf4 holding a value of the second case.
+//
+// Type signature:
+//
+//	Case2Of4 :: T2 -> OneOf4 T1 T2 T3 T4
+func Case2Of4[T1, T2, T3, T4 any](v T2) OneOf4[T1, T2, T3, T4] {
+	return OneOf4[T1, T2, T3, T4]{index: 1, v2: v}
+}
+
+// Case3Of4 creates a OneOf4 holding a value of the third case.
+//
+// Type signature:
+//
+//	Case3Of4 :: T3 -> OneOf4 T1 T2 T3 T4
+func Case3Of4[T1, T2, T3, T4 any](v T3) OneOf4[T1, T2, T3, T4] {
+	return OneOf4[T1, T2, T3, T4]{index: 2, v3: v}
+}
+
+// Case4Of4 creates a OneOf4 holding a value of the fourth case.
+//
+// Type signature:
+//
+//	Case4Of4 :: T4 -> OneOf4 T1 T2 T3 T4
+func Case4Of4[T1, T2, T3, T4 any](v T4) OneOf4[T1, T2, T3, T4] {
+	return OneOf4[T1, T2, T3, T4]{index: 3, v4: v}
+}
+
+// Index returns the number of the case held by the OneOf4, counting from 1.
+//
+// Type signature:
+//
+//	Index :: OneOf4 T1 T2 T3 T4 -> Int
+func (o OneOf4[T1, T2, T3, T4]) Index() int {
+	return int(o.index) + 1
+}
+
+// Is1 returns true if the OneOf4 holds a value of the first case.
+//
+// Type signature:
+//
+//	Is1 :: OneOf4 T1 T2 T3 T4 -> Bool
+func (o OneOf4[T1, T2, T3, T4]) Is1() bool {
+	return o.index == 0
+}
+
+// As1 returns the value of the first case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	As1 :: OneOf4 T1 T2 T3 T4 -> Option T1
+//
+// It returns Some with the value if the OneOf4 holds the first case, or None otherwise.
+func (o OneOf4[T1, T2, T3, T4]) As1() option.Option[T1] {
+	if o.index == 0 {
+		return option.Some(o.v1)
+	}
+	return option.None[T1]()
+}
+
+// Is2 returns true if the OneOf4 holds a value of the second case.
+//
+// Type signature:
+//
+//	Is2 :: OneOf4 T1 T2 T3 T4 -> Bool
+func (o OneOf4[T1, T2, T3, T4]) Is2() bool {
+	return o.index == 1
+}
+
+// As2 returns the value of the second case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	As2 :: OneOf4 T1 T2 T3 T4 -> Option T2
+//
+// It returns Some with the value if the OneOf4 holds the second case, or None otherwise.
+func (o OneOf4[T1, T2, T3, T4]) As2() option.Option[T2] {
+	if o.index == 1 {
+		return option.Some(o.v2)
+	}
+	return option.None[T2]()
+}
+
+// Is3 returns true if the OneOf4 holds a value of the third case.
+//
+// Type signature:
+//
+//	Is3 :: OneOf4 T1 T2 T3 T4 -> Bool
+func (o OneOf4[T1, T2, T3, T4]) Is3() bool {
+	return o.index == 2
+}
+
+// As3 returns the value of the third case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	As3 :: OneOf4 T1 T2 T3 T4 -> Option T3
+//
+// It returns Some with the value if the OneOf4 holds the third case, or None otherwise.
+func (o OneOf4[T1, T2, T3, T4]) As3() option.Option[T3] {
+	if o.index == 2 {
+		return option.Some(o.v3)
+	}
+	return option.None[T3]()
+}
+
+// Is4 returns true if the OneOf4 holds a value of the fourth case.
+//
+// Type signature:
+//
+//	Is4 :: OneOf4 T1 T2 T3 T4 -> Bool
+func (o OneOf4[T1, T2, T3, T4]) Is4() bool {
+	return o.index == 3
+}
+
+// As4 returns the value of the fourth case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	As4 :: OneOf4 T1 T2 T3 T4 -> Option T4
+//
+// It returns Some with the value if the OneOf4 holds the fourth case, or None otherwise.
+func (o OneOf4[T1, T2, T3, T4]) As4() option.Option[T4] {
+	if o.index == 3 {
+		return option.Some(o.v4)
+	}
+	return option.None[T4]()
+}
+
+// Match applies the handler for the case held by the OneOf4.
+//
+// Type signature:
+//
+//	Match :: OneOf4 T1 T2 T3 T4 -> (T1 -> ()) -> (T2 -> ()) -> (T3 -> ()) -> (T4 -> ()) -> ()
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the match is exhaustive.
+func (o OneOf4[T1, T2, T3, T4]) Match(f1 func(T1), f2 func(T2), f3 func(T3), f4 func(T4)) {
+	switch o.index {
+	case 1:
+		f2(o.v2)
+	case 2:
+		f3(o.v3)
+	case 3:
+		f4(o.v4)
+	default:
+		f1(o.v1)
+	}
+}
+
+// Fold4 collapses a OneOf4 into a single value by applying the handler for the case it holds.
+//
+// Type signature:
+//
+//	Fold4 :: OneOf4 T1 T2 T3 T4 -> (T1 -> U) -> (T2 -> U) -> (T3 -> U) -> (T4 -> U) -> U
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the fold is exhaustive.
+func Fold4[T1, T2, T3, T4, U any](o OneOf4[T1, T2, T3, T4], f1 func(T1) U, f2 func(T2) U, f3 func(T3) U, f4 func(T4) U) U {
+	switch o.index {
+	case 1:
+		return f2(o.v2)
+	case 2:
+		return f3(o.v3)
+	case 3:
+		return f4(o.v4)
+	default:
+		return f1(o.v1)
+	}
+}
+
+// Map1Of4 applies a function to the value of the first case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	Map1Of4 :: OneOf4 T1 T2 T3 T4 -> (T1 -> U) -> OneOf4 U T2 T3 T4
+//
+// If the OneOf4 holds any other case, that value is carried over unchanged.
+func Map1Of4[T1, T2, T3, T4, U any](o OneOf4[T1, T2, T3, T4], fn func(T1) U) OneOf4[U, T2, T3, T4] {
+	if o.index == 0 {
+		return OneOf4[U, T2, T3, T4]{index: o.index, v1: fn(o.v1)}
+	}
+	return OneOf4[U, T2, T3, T4]{index: o.index, v2: o.v2, v3: o.v3, v4: o.v4}
+}
+
+// Map2Of4 applies a function to the value of the second case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	Map2Of4 :: OneOf4 T1 T2 T3 T4 -> (T2 -> U) -> OneOf4 T1 U T3 T4
+//
+// If the OneOf4 holds any other case, that value is carried over unchanged.
+func Map2Of4[T1, T2, T3, T4, U any](o OneOf4[T1, T2, T3, T4], fn func(T2) U) OneOf4[T1, U, T3, T4] {
+	if o.index == 1 {
+		return OneOf4[T1, U, T3, T4]{index: o.index, v2: fn(o.v2)}
+	}
+	return OneOf4[T1, U, T3, T4]{index: o.index, v1: o.v1, v3: o.v3, v4: o.v4}
+}
+
+// Map3Of4 applies a function to the value of the third case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	Map3Of4 :: OneOf4 T1 T2 T3 T4 -> (T3 -> U) -> OneOf4 T1 T2 U T4
+//
+// If the OneOf4 holds any other case, that value is carried over unchanged.
+func Map3Of4[T1, T2, T3, T4, U any](o OneOf4[T1, T2, T3, T4], fn func(T3) U) OneOf4[T1, T2, U, T4] {
+	if o.index == 2 {
+		return OneOf4[T1, T2, U, T4]{index: o.index, v3: fn(o.v3)}
+	}
+	return OneOf4[T1, T2, U, T4]{index: o.index, v1: o.v1, v2: o.v2, v4: o.v4}
+}
+
+// Map4Of4 applies a function to the value of the fourth case, if the OneOf4 holds it.
+//
+// Type signature:
+//
+//	Map4Of4 :: OneOf4 T1 T2 T3 T4 -> (T4 -> U) -> OneOf4 T1 T2 T3 U
+//
+// If the OneOf4 holds any other case, that value is carried over unchanged.
+func Map4Of4[T1, T2, T3, T4, U any](o OneOf4[T1, T2, T3, T4], fn func(T4) U) OneOf4[T1, T2, T3, U] {
+	if o.index == 3 {
+		return OneOf4[T1, T2, T3, U]{index: o.index, v4: fn(o.v4)}
+	}
+	return OneOf4[T1, T2, T3, U]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3}
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The value is encoded as an object with a "tag" discriminator naming the case, from "case1" onwards,
+// and a "value" holding the encoded value of that case, such as {"tag":"case2","value":"x"}.
+func (o OneOf4[T1, T2, T3, T4]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return marshalTagged("case2", o.v2)
+	case 2:
+		return marshalTagged("case3", o.v3)
+	case 3:
+		return marshalTagged("case4", o.v4)
+	default:
+		return marshalTagged("case1", o.v1)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is not one of "case1" to "case4".
+// As with encoding/json, a JSON null leaves the OneOf4 unchanged.
+func (o *OneOf4[T1, T2, T3, T4]) UnmarshalJSON(data []byte) error {
+	tagged, err := unmarshalTagged(data)
+	if err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "case1":
+		var v T1
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case1Of4[T1, T2, T3, T4](v)
+	case "case2":
+		var v T2
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case2Of4[T1, T2, T3, T4](v)
+	case "case3":
+		var v T3
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case3Of4[T1, T2, T3, T4](v)
+	case "case4":
+		var v T4
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case4Of4[T1, T2, T3, T4](v)
+	default:
+		return fmt.Errorf("oneof: unknown tag %q for OneOf4", tagged.Tag)
+	}
+	return nil
+}
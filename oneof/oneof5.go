@@ -0,0 +1,372 @@
+package oneof
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// OneOf5 represents a value that is exactly one of five cases.
+//
+// Type signature:
+//
+//	OneOf5[T1, T2, T3, T4, T5] :: T1 | T2 | T3 | T4 | T5 -> OneOf5 T1 T2 T3 T4 T5
+//
+// The zero value holds the zero value of the first case.
+type OneOf5[T1, T2, T3, T4, T5 any] struct {
+	index uint8
+	v1    T1
+	v2    T2
+	v3    T3
+	v4    T4
+	v5    T5
+}
+
+// Case1Of5 creates a OneOf5 holding a value of the first case.
+//
+// Type signature:
+//
+//	Case1Of5 :: T1 -> OneOf5 T1 T2 T3 T4 T5
+func Case1Of5[T1, T2, T3, T4, T5 any](v T1) OneOf5[T1, T2, T3, T4, T5] {
+	return OneOf5[T1, T2, T3, T4, T5]{index: 0, v1: v}
+}
+
+// Case2Of5 creates a OneOf5 holding a value of the second case.
+//
+// Type signature:
+//
+//	Case2Of5 :: T2 -> OneOf5 T1 T2 T3 T4 T5
+func Case2Of5[T1, T2, T3, T4, T5 any](v T2) OneOf5[T1, T2, T3, T4, T5] {
+	return OneOf5[T1, T2, T3, T4, T5]{index: 1, v2: v}
+}
+
+// Case3Of5 creates a OneOf5 holding a value of the third case.
+//
+// Type signature:
+//
+//	Case3Of5 :: T3 -> OneOf5 T1 T2 T3 T4 T5
+func Case3Of5[T1, T2, T3, T4, T5 any](v T3) OneOf5[T1, T2, T3, T4, T5] {
+	return OneOf5[T1, T2, T3, T4, T5]{index: 2, v3: v}
+}
+
+// Case4Of5 creates a OneOf5 holding a value of the fourth case.
+//
+// Type signature:
+//
+//	Case4Of5 :: T4 -> OneOf5 T1 T2 T3 T4 T5
+func Case4Of5[T1, T2, T3, T4, T5 any](v T4) OneOf5[T1, T2, T3, T4, T5] {
+	return OneOf5[T1, T2, T3, T4, T5]{index: 3, v4: v}
+}
+
+// Case5Of5 creates a OneOf5 holding a value of the fifth case.
+//
+// Type signature:
+//
+//	Case5Of5 :: T5 -> OneOf5 T1 T2 T3 T4 T5
+func Case5Of5[T1, T2, T3, T4, T5 any](v T5) OneOf5[T1, T2, T3, T4, T5] {
+	return OneOf5[T1, T2, T3, T4, T5]{index: 4, v5: v}
+}
+
+// Index returns the number of the case held by the OneOf5, counting from 1.
+//
+// Type signature:
+//
+//	Index :: OneOf5 T1 T2 T3 T4 T5 -> Int
+func (o OneOf5[T1, T2, T3, T4, T5]) Index() int {
+	return int(o.index) + 1
+}
+
+// Is1 returns true if the OneOf5 holds a value of the first case.
+//
+// Type signature:
+//
+//	Is1 :: OneOf5 T1 T2 T3 T4 T5 -> Bool
+func (o OneOf5[T1, T2, T3, T4, T5]) Is1() bool {
+	return o.index == 0
+}
+
+// As1 returns the value of the first case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	As1 :: OneOf5 T1 T2 T3 T4 T5 -> Option T1
+//
+// It returns Some with the value if the OneOf5 holds the first case, or None otherwise.
+func (o OneOf5[T1, T2, T3, T4, T5]) As1() option.Option[T1] {
+	if o.index == 0 {
+		return option.Some(o.v1)
+	}
+	return option.None[T1]()
+}
+
+// Is2 returns true if the OneOf5 holds a value of the second case.
+//
+// Type signature:
+//
+//	Is2 :: OneOf5 T1 T2 T3 T4 T5 -> Bool
+func (o OneOf5[T1, T2, T3, T4, T5]) Is2() bool {
+	return o.index == 1
+}
+
+// As2 returns the value of the second case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	As2 :: OneOf5 T1 T2 T3 T4 T5 -> Option T2
+//
+// It returns Some with the value if the OneOf5 holds the second case, or None otherwise.
+func (o OneOf5[T1, T2, T3, T4, T5]) As2() option.Option[T2] {
+	if o.index == 1 {
+		return option.Some(o.v2)
+	}
+	return option.None[T2]()
+}
+
+// Is3 returns true if the OneOf5 holds a value of the third case.
+//
+// Type signature:
+//
+//	Is3 :: OneOf5 T1 T2 T3 T4 T5 -> Bool
+func (o OneOf5[T1, T2, T3, T4, T5]) Is3() bool {
+	return o.index == 2
+}
+
+// As3 returns the value of the third case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	As3 :: OneOf5 T1 T2 T3 T4 T5 -> Option T3
+//
+// It returns Some with the value if the OneOf5 holds the third case, or None otherwise.
+func (o OneOf5[T1, T2, T3, T4, T5]) As3() option.Option[T3] {
+	if o.index == 2 {
+		return option.Some(o.v3)
+	}
+	return option.None[T3]()
+}
+
+// Is4 returns true if the OneOf5 holds a value of the fourth case.
+//
+// Type signature:
+//
+//	Is4 :: OneOf5 T1 T2 T3 T4 T5 -> Bool
+func (o OneOf5[T1, T2, T3, T4, T5]) Is4() bool {
+	return o.index == 3
+}
+
+// As4 returns the value of the fourth case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	As4 :: OneOf5 T1 T2 T3 T4 T5 -> Option T4
+//
+// It returns Some with the value if the OneOf5 holds the fourth case, or None otherwise.
+func (o OneOf5[T1, T2, T3, T4, T5]) As4() option.Option[T4] {
+	if o.index == 3 {
+		return option.Some(o.v4)
+	}
+	return option.None[T4]()
+}
+
+// Is5 returns true if the OneOf5 holds a value of the fifth case.
+//
+// Type signature:
+//
+//	Is5 :: OneOf5 T1 T2 T3 T4 T5 -> Bool
+func (o OneOf5[T1, T2, T3, T4, T5]) Is5() bool {
+	return o.index == 4
+}
+
+// As5 returns the value of the fifth case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	As5 :: OneOf5 T1 T2 T3 T4 T5 -> Option T5
+//
+// It returns Some with the value if the OneOf5 holds the fifth case, or None otherwise.
+func (o OneOf5[T1, T2, T3, T4, T5]) As5() option.Option[T5] {
+	if o.index == 4 {
+		return option.Some(o.v5)
+	}
+	return option.None[T5]()
+}
+
+// Match applies the handler for the case held by the OneOf5.
+//
+// Type signature:
+//
+//	Match :: OneOf5 T1 T2 T3 T4 T5 -> (T1 -> ()) -> (T2 -> ()) -> (T3 -> ()) -> (T4 -> ()) -> (T5 -> ()) -> ()
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the match is exhaustive.
+func (o OneOf5[T1, T2, T3, T4, T5]) Match(f1 func(T1), f2 func(T2), f3 func(T3), f4 func(T4), f5 func(T5)) {
+	switch o.index {
+	case 1:
+		f2(o.v2)
+	case 2:
+		f3(o.v3)
+	case 3:
+		f4(o.v4)
+	case 4:
+		f5(o.v5)
+	default:
+		f1(o.v1)
+	}
+}
+
+// Fold5 collapses a OneOf5 into a single value by applying the handler for the case it holds.
+//
+// Type signature:
+//
+//	Fold5 :: OneOf5 T1 T2 T3 T4 T5 -> (T1 -> U) -> (T2 -> U) -> (T3 -> U) -> (T4 -> U) -> (T5 -> U) -> U
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the fold is exhaustive.
+func Fold5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], f1 func(T1) U, f2 func(T2) U, f3 func(T3) U, f4 func(T4) U, f5 func(T5) U) U {
+	switch o.index {
+	case 1:
+		return f2(o.v2)
+	case 2:
+		return f3(o.v3)
+	case 3:
+		return f4(o.v4)
+	case 4:
+		return f5(o.v5)
+	default:
+		return f1(o.v1)
+	}
+}
+
+// Map1Of5 applies a function to the value of the first case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	Map1Of5 :: OneOf5 T1 T2 T3 T4 T5 -> (T1 -> U) -> OneOf5 U T2 T3 T4 T5
+//
+// If the OneOf5 holds any other case, that value is carried over unchanged.
+func Map1Of5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], fn func(T1) U) OneOf5[U, T2, T3, T4, T5] {
+	if o.index == 0 {
+		return OneOf5[U, T2, T3, T4, T5]{index: o.index, v1: fn(o.v1)}
+	}
+	return OneOf5[U, T2, T3, T4, T5]{index: o.index, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5}
+}
+
+// Map2Of5 applies a function to the value of the second case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	Map2Of5 :: OneOf5 T1 T2 T3 T4 T5 -> (T2 -> U) -> OneOf5 T1 U T3 T4 T5
+//
+// If the OneOf5 holds any other case, that value is carried over unchanged.
+func Map2Of5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], fn func(T2) U) OneOf5[T1, U, T3, T4, T5] {
+	if o.index == 1 {
+		return OneOf5[T1, U, T3, T4, T5]{index: o.index, v2: fn(o.v2)}
+	}
+	return OneOf5[T1, U, T3, T4, T5]{index: o.index, v1: o.v1, v3: o.v3, v4: o.v4, v5: o.v5}
+}
+
+// Map3Of5 applies a function to the value of the third case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	Map3Of5 :: OneOf5 T1 T2 T3 T4 T5 -> (T3 -> U) -> OneOf5 T1 T2 U T4 T5
+//
+// If the OneOf5 holds any other case, that value is carried over unchanged.
+func Map3Of5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], fn func(T3) U) OneOf5[T1, T2, U, T4, T5] {
+	if o.index == 2 {
+		return OneOf5[T1, T2, U, T4, T5]{index: o.index, v3: fn(o.v3)}
+	}
+	return OneOf5[T1, T2, U, T4, T5]{index: o.index, v1: o.v1, v2: o.v2, v4: o.v4, v5: o.v5}
+}
+
+// Map4Of5 applies a function to the value of the fourth case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	Map4Of5 :: OneOf5 T1 T2 T3 T4 T5 -> (T4 -> U) -> OneOf5 T1 T2 T3 U T5
+//
+// If the OneOf5 holds any other case, that value is carried over unchanged.
+func Map4Of5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], fn func(T4) U) OneOf5[T1, T2, T3, U, T5] {
+	if o.index == 3 {
+		return OneOf5[T1, T2, T3, U, T5]{index: o.index, v4: fn(o.v4)}
+	}
+	return OneOf5[T1, T2, T3, U, T5]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v5: o.v5}
+}
+
+// Map5Of5 applies a function to the value of the fifth case, if the OneOf5 holds it.
+//
+// Type signature:
+//
+//	Map5Of5 :: OneOf5 T1 T2 T3 T4 T5 -> (T5 -> U) -> OneOf5 T1 T2 T3 T4 U
+//
+// If the OneOf5 holds any other case, that value is carried over unchanged.
+func Map5Of5[T1, T2, T3, T4, T5, U any](o OneOf5[T1, T2, T3, T4, T5], fn func(T5) U) OneOf5[T1, T2, T3, T4, U] {
+	if o.index == 4 {
+		return OneOf5[T1, T2, T3, T4, U]{index: o.index, v5: fn(o.v5)}
+	}
+	return OneOf5[T1, T2, T3, T4, U]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4}
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The value is encoded as an object with a "tag" discriminator naming the case, from "case1" onwards,
+// and a "value" holding the encoded value of that case, such as {"tag":"case2","value":"x"}.
+func (o OneOf5[T1, T2, T3, T4, T5]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return marshalTagged("case2", o.v2)
+	case 2:
+		return marshalTagged("case3", o.v3)
+	case 3:
+		return marshalTagged("case4", o.v4)
+	case 4:
+		return marshalTagged("case5", o.v5)
+	default:
+		return marshalTagged("case1", o.v1)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is not one of "case1" to "case5".
+// As with encoding/json, a JSON null leaves the OneOf5 unchanged.
+func (o *OneOf5[T1, T2, T3, T4, T5]) UnmarshalJSON(data []byte) error {
+	tagged, err := unmarshalTagged(data)
+	if err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "case1":
+		var v T1
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case1Of5[T1, T2, T3, T4, T5](v)
+	case "case2":
+		var v T2
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case2Of5[T1, T2, T3, T4, T5](v)
+	case "case3":
+		var v T3
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case3Of5[T1, T2, T3, T4, T5](v)
+	case "case4":
+		var v T4
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case4Of5[T1, T2, T3, T4, T5](v)
+	case "case5":
+		var v T5
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case5Of5[T1, T2, T3, T4, T5](v)
+	default:
+		return fmt.Errorf("oneof: unknown tag %q for OneOf5", tagged.Tag)
+	}
+	return nil
+}
@@ -0,0 +1,431 @@
+package oneof
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// OneOf6 represents a value that is exactly one of six cases.
+//
+// Type signature:
+//
+//	OneOf6[T1, T2, T3, T4, T5, T6] :: T1 | T2 | T3 | T4 | T5 | T6 -> OneOf6 T1 T2 T3 T4 T5 T6
+//
+// The zero value holds the zero value of the first case.
+type OneOf6[T1, T2, T3, T4, T5, T6 any] struct {
+	index uint8
+	v1    T1
+	v2    T2
+	v3    T3
+	v4    T4
+	v5    T5
+	v6    T6
+}
+
+// Case1Of6 creates a OneOf6 holding a value of the first case.
+//
+// Type signature:
+//
+//	Case1Of6 :: T1 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case1Of6[T1, T2, T3, T4, T5, T6 any](v T1) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 0, v1: v}
+}
+
+// Case2Of6 creates a OneOf6 holding a value of the second case.
+//
+// Type signature:
+//
+//	Case2Of6 :: T2 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case2Of6[T1, T2, T3, T4, T5, T6 any](v T2) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 1, v2: v}
+}
+
+// Case3Of6 creates a OneOf6 holding a value of the third case.
+//
+// Type signature:
+//
+//	Case3Of6 :: T3 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case3Of6[T1, T2, T3, T4, T5, T6 any](v T3) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 2, v3: v}
+}
+
+// Case4Of6 creates a OneOf6 holding a value of the fourth case.
+//
+// Type signature:
+//
+//	Case4Of6 :: T4 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case4Of6[T1, T2, T3, T4, T5, T6 any](v T4) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 3, v4: v}
+}
+
+// Case5Of6 creates a OneOf6 holding a value of the fifth case.
+//
+// Type signature:
+//
+//	Case5Of6 :: T5 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case5Of6[T1, T2, T3, T4, T5, T6 any](v T5) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 4, v5: v}
+}
+
+// Case6Of6 creates a OneOf6 holding a value of the sixth case.
+//
+// Type signature:
+//
+//	Case6Of6 :: T6 -> OneOf6 T1 T2 T3 T4 T5 T6
+func Case6Of6[T1, T2, T3, T4, T5, T6 any](v T6) OneOf6[T1, T2, T3, T4, T5, T6] {
+	return OneOf6[T1, T2, T3, T4, T5, T6]{index: 5, v6: v}
+}
+
+// Index returns the number of the case held by the OneOf6, counting from 1.
+//
+// Type signature:
+//
+//	Index :: OneOf6 T1 T2 T3 T4 T5 T6 -> Int
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Index() int {
+	return int(o.index) + 1
+}
+
+// Is1 returns true if the OneOf6 holds a value of the first case.
+//
+// Type signature:
+//
+//	Is1 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is1() bool {
+	return o.index == 0
+}
+
+// As1 returns the value of the first case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As1 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T1
+//
+// It returns Some with the value if the OneOf6 holds the first case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As1() option.Option[T1] {
+	if o.index == 0 {
+		return option.Some(o.v1)
+	}
+	return option.None[T1]()
+}
+
+// Is2 returns true if the OneOf6 holds a value of the second case.
+//
+// Type signature:
+//
+//	Is2 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is2() bool {
+	return o.index == 1
+}
+
+// As2 returns the value of the second case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As2 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T2
+//
+// It returns Some with the value if the OneOf6 holds the second case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As2() option.Option[T2] {
+	if o.index == 1 {
+		return option.Some(o.v2)
+	}
+	return option.None[T2]()
+}
+
+// Is3 returns true if the OneOf6 holds a value of the third case.
+//
+// Type signature:
+//
+//	Is3 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is3() bool {
+	return o.index == 2
+}
+
+// As3 returns the value of the third case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As3 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T3
+//
+// It returns Some with the value if the OneOf6 holds the third case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As3() option.Option[T3] {
+	if o.index == 2 {
+		return option.Some(o.v3)
+	}
+	return option.None[T3]()
+}
+
+// Is4 returns true if the OneOf6 holds a value of the fourth case.
+//
+// Type signature:
+//
+//	Is4 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is4() bool {
+	return o.index == 3
+}
+
+// As4 returns the value of the fourth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As4 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T4
+//
+// It returns Some with the value if the OneOf6 holds the fourth case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As4() option.Option[T4] {
+	if o.index == 3 {
+		return option.Some(o.v4)
+	}
+	return option.None[T4]()
+}
+
+// Is5 returns true if the OneOf6 holds a value of the fifth case.
+//
+// Type signature:
+//
+//	Is5 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is5() bool {
+	return o.index == 4
+}
+
+// As5 returns the value of the fifth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As5 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T5
+//
+// It returns Some with the value if the OneOf6 holds the fifth case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As5() option.Option[T5] {
+	if o.index == 4 {
+		return option.Some(o.v5)
+	}
+	return option.None[T5]()
+}
+
+// Is6 returns true if the OneOf6 holds a value of the sixth case.
+//
+// Type signature:
+//
+//	Is6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Bool
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Is6() bool {
+	return o.index == 5
+}
+
+// As6 returns the value of the sixth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	As6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> Option T6
+//
+// It returns Some with the value if the OneOf6 holds the sixth case, or None otherwise.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) As6() option.Option[T6] {
+	if o.index == 5 {
+		return option.Some(o.v6)
+	}
+	return option.None[T6]()
+}
+
+// Match applies the handler for the case held by the OneOf6.
+//
+// Type signature:
+//
+//	Match :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T1 -> ()) -> (T2 -> ()) -> (T3 -> ()) -> (T4 -> ()) -> (T5 -> ()) -> (T6 -> ()) -> ()
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the match is exhaustive.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) Match(f1 func(T1), f2 func(T2), f3 func(T3), f4 func(T4), f5 func(T5), f6 func(T6)) {
+	switch o.index {
+	case 1:
+		f2(o.v2)
+	case 2:
+		f3(o.v3)
+	case 3:
+		f4(o.v4)
+	case 4:
+		f5(o.v5)
+	case 5:
+		f6(o.v6)
+	default:
+		f1(o.v1)
+	}
+}
+
+// Fold6 collapses a OneOf6 into a single value by applying the handler for the case it holds.
+//
+// Type signature:
+//
+//	Fold6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T1 -> U) -> (T2 -> U) -> (T3 -> U) -> (T4 -> U) -> (T5 -> U) -> (T6 -> U) -> U
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the fold is exhaustive.
+func Fold6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], f1 func(T1) U, f2 func(T2) U, f3 func(T3) U, f4 func(T4) U, f5 func(T5) U, f6 func(T6) U) U {
+	switch o.index {
+	case 1:
+		return f2(o.v2)
+	case 2:
+		return f3(o.v3)
+	case 3:
+		return f4(o.v4)
+	case 4:
+		return f5(o.v5)
+	case 5:
+		return f6(o.v6)
+	default:
+		return f1(o.v1)
+	}
+}
+
+// Map1Of6 applies a function to the value of the first case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map1Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T1 -> U) -> OneOf6 U T2 T3 T4 T5 T6
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map1Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T1) U) OneOf6[U, T2, T3, T4, T5, T6] {
+	if o.index == 0 {
+		return OneOf6[U, T2, T3, T4, T5, T6]{index: o.index, v1: fn(o.v1)}
+	}
+	return OneOf6[U, T2, T3, T4, T5, T6]{index: o.index, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6}
+}
+
+// Map2Of6 applies a function to the value of the second case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map2Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T2 -> U) -> OneOf6 T1 U T3 T4 T5 T6
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map2Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T2) U) OneOf6[T1, U, T3, T4, T5, T6] {
+	if o.index == 1 {
+		return OneOf6[T1, U, T3, T4, T5, T6]{index: o.index, v2: fn(o.v2)}
+	}
+	return OneOf6[T1, U, T3, T4, T5, T6]{index: o.index, v1: o.v1, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6}
+}
+
+// Map3Of6 applies a function to the value of the third case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map3Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T3 -> U) -> OneOf6 T1 T2 U T4 T5 T6
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map3Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T3) U) OneOf6[T1, T2, U, T4, T5, T6] {
+	if o.index == 2 {
+		return OneOf6[T1, T2, U, T4, T5, T6]{index: o.index, v3: fn(o.v3)}
+	}
+	return OneOf6[T1, T2, U, T4, T5, T6]{index: o.index, v1: o.v1, v2: o.v2, v4: o.v4, v5: o.v5, v6: o.v6}
+}
+
+// Map4Of6 applies a function to the value of the fourth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map4Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T4 -> U) -> OneOf6 T1 T2 T3 U T5 T6
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map4Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T4) U) OneOf6[T1, T2, T3, U, T5, T6] {
+	if o.index == 3 {
+		return OneOf6[T1, T2, T3, U, T5, T6]{index: o.index, v4: fn(o.v4)}
+	}
+	return OneOf6[T1, T2, T3, U, T5, T6]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v5: o.v5, v6: o.v6}
+}
+
+// Map5Of6 applies a function to the value of the fifth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map5Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T5 -> U) -> OneOf6 T1 T2 T3 T4 U T6
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map5Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T5) U) OneOf6[T1, T2, T3, T4, U, T6] {
+	if o.index == 4 {
+		return OneOf6[T1, T2, T3, T4, U, T6]{index: o.index, v5: fn(o.v5)}
+	}
+	return OneOf6[T1, T2, T3, T4, U, T6]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v6: o.v6}
+}
+
+// Map6Of6 applies a function to the value of the sixth case, if the OneOf6 holds it.
+//
+// Type signature:
+//
+//	Map6Of6 :: OneOf6 T1 T2 T3 T4 T5 T6 -> (T6 -> U) -> OneOf6 T1 T2 T3 T4 T5 U
+//
+// If the OneOf6 holds any other case, that value is carried over unchanged.
+func Map6Of6[T1, T2, T3, T4, T5, T6, U any](o OneOf6[T1, T2, T3, T4, T5, T6], fn func(T6) U) OneOf6[T1, T2, T3, T4, T5, U] {
+	if o.index == 5 {
+		return OneOf6[T1, T2, T3, T4, T5, U]{index: o.index, v6: fn(o.v6)}
+	}
+	return OneOf6[T1, T2, T3, T4, T5, U]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5}
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The value is encoded as an object with a "tag" discriminator naming the case, from "case1" onwards,
+// and a "value" holding the encoded value of that case, such as {"tag":"case2","value":"x"}.
+func (o OneOf6[T1, T2, T3, T4, T5, T6]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return marshalTagged("case2", o.v2)
+	case 2:
+		return marshalTagged("case3", o.v3)
+	case 3:
+		return marshalTagged("case4", o.v4)
+	case 4:
+		return marshalTagged("case5", o.v5)
+	case 5:
+		return marshalTagged("case6", o.v6)
+	default:
+		return marshalTagged("case1", o.v1)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is not one of "case1" to "case6".
+// As with encoding/json, a JSON null leaves the OneOf6 unchanged.
+func (o *OneOf6[T1, T2, T3, T4, T5, T6]) UnmarshalJSON(data []byte) error {
+	tagged, err := unmarshalTagged(data)
+	if err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "case1":
+		var v T1
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case1Of6[T1, T2, T3, T4, T5, T6](v)
+	case "case2":
+		var v T2
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case2Of6[T1, T2, T3, T4, T5, T6](v)
+	case "case3":
+		var v T3
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case3Of6[T1, T2, T3, T4, T5, T6](v)
+	case "case4":
+		var v T4
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case4Of6[T1, T2, T3, T4, T5, T6](v)
+	case "case5":
+		var v T5
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case5Of6[T1, T2, T3, T4, T5, T6](v)
+	case "case6":
+		var v T6
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case6Of6[T1, T2, T3, T4, T5, T6](v)
+	default:
+		return fmt.Errorf("oneof: unknown tag %q for OneOf6", tagged.Tag)
+	}
+	return nil
+}
@@ -0,0 +1,549 @@
+package oneof
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// OneOf8 represents a value that is exactly one of eight cases.
+//
+// Type signature:
+//
+//	OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] :: T1 | T2 | T3 | T4 | T5 | T6 | T7 | T8 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+//
+// The zero value holds the zero value of the first case.
+type OneOf8[T1, T2, T3, T4, T5, T6, T7, T8 any] struct {
+	index uint8
+	v1    T1
+	v2    T2
+	v3    T3
+	v4    T4
+	v5    T5
+	v6    T6
+	v7    T7
+	v8    T8
+}
+
+// Case1Of8 creates a OneOf8 holding a value of the first case.
+//
+// Type signature:
+//
+//	Case1Of8 :: T1 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case1Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T1) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 0, v1: v}
+}
+
+// Case2Of8 creates a OneOf8 holding a value of the second case.
+//
+// Type signature:
+//
+//	Case2Of8 :: T2 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case2Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T2) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 1, v2: v}
+}
+
+// Case3Of8 creates a OneOf8 holding a value of the third case.
+//
+// Type signature:
+//
+//	Case3Of8 :: T3 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case3Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T3) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 2, v3: v}
+}
+
+// Case4Of8 creates a OneOf8 holding a value of the fourth case.
+//
+// Type signature:
+//
+//	Case4Of8 :: T4 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case4Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T4) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 3, v4: v}
+}
+
+// Case5Of8 creates a OneOf8 holding a value of the fifth case.
+//
+// Type signature:
+//
+//	Case5Of8 :: T5 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case5Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T5) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 4, v5: v}
+}
+
+// Case6Of8 creates a OneOf8 holding a value of the sixth case.
+//
+// Type signature:
+//
+//	Case6Of8 :: T6 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case6Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T6) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 5, v6: v}
+}
+
+// Case7Of8 creates a OneOf8 holding a value of the seventh case.
+//
+// Type signature:
+//
+//	Case7Of8 :: T7 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case7Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T7) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 6, v7: v}
+}
+
+// Case8Of8 creates a OneOf8 holding a value of the eighth case.
+//
+// Type signature:
+//
+//	Case8Of8 :: T8 -> OneOf8 T1 T2 T3 T4 T5 T6 T7 T8
+func Case8Of8[T1, T2, T3, T4, T5, T6, T7, T8 any](v T8) OneOf8[T1, T2, T3, T4, T5, T6, T7, T8] {
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]{index: 7, v8: v}
+}
+
+// Index returns the number of the case held by the OneOf8, counting from 1.
+//
+// Type signature:
+//
+//	Index :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Int
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Index() int {
+	return int(o.index) + 1
+}
+
+// Is1 returns true if the OneOf8 holds a value of the first case.
+//
+// Type signature:
+//
+//	Is1 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is1() bool {
+	return o.index == 0
+}
+
+// As1 returns the value of the first case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As1 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T1
+//
+// It returns Some with the value if the OneOf8 holds the first case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As1() option.Option[T1] {
+	if o.index == 0 {
+		return option.Some(o.v1)
+	}
+	return option.None[T1]()
+}
+
+// Is2 returns true if the OneOf8 holds a value of the second case.
+//
+// Type signature:
+//
+//	Is2 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is2() bool {
+	return o.index == 1
+}
+
+// As2 returns the value of the second case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As2 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T2
+//
+// It returns Some with the value if the OneOf8 holds the second case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As2() option.Option[T2] {
+	if o.index == 1 {
+		return option.Some(o.v2)
+	}
+	return option.None[T2]()
+}
+
+// Is3 returns true if the OneOf8 holds a value of the third case.
+//
+// Type signature:
+//
+//	Is3 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is3() bool {
+	return o.index == 2
+}
+
+// As3 returns the value of the third case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As3 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T3
+//
+// It returns Some with the value if the OneOf8 holds the third case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As3() option.Option[T3] {
+	if o.index == 2 {
+		return option.Some(o.v3)
+	}
+	return option.None[T3]()
+}
+
+// Is4 returns true if the OneOf8 holds a value of the fourth case.
+//
+// Type signature:
+//
+//	Is4 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is4() bool {
+	return o.index == 3
+}
+
+// As4 returns the value of the fourth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As4 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T4
+//
+// It returns Some with the value if the OneOf8 holds the fourth case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As4() option.Option[T4] {
+	if o.index == 3 {
+		return option.Some(o.v4)
+	}
+	return option.None[T4]()
+}
+
+// Is5 returns true if the OneOf8 holds a value of the fifth case.
+//
+// Type signature:
+//
+//	Is5 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is5() bool {
+	return o.index == 4
+}
+
+// As5 returns the value of the fifth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As5 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T5
+//
+// It returns Some with the value if the OneOf8 holds the fifth case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As5() option.Option[T5] {
+	if o.index == 4 {
+		return option.Some(o.v5)
+	}
+	return option.None[T5]()
+}
+
+// Is6 returns true if the OneOf8 holds a value of the sixth case.
+//
+// Type signature:
+//
+//	Is6 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is6() bool {
+	return o.index == 5
+}
+
+// As6 returns the value of the sixth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As6 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T6
+//
+// It returns Some with the value if the OneOf8 holds the sixth case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As6() option.Option[T6] {
+	if o.index == 5 {
+		return option.Some(o.v6)
+	}
+	return option.None[T6]()
+}
+
+// Is7 returns true if the OneOf8 holds a value of the seventh case.
+//
+// Type signature:
+//
+//	Is7 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is7() bool {
+	return o.index == 6
+}
+
+// As7 returns the value of the seventh case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As7 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T7
+//
+// It returns Some with the value if the OneOf8 holds the seventh case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As7() option.Option[T7] {
+	if o.index == 6 {
+		return option.Some(o.v7)
+	}
+	return option.None[T7]()
+}
+
+// Is8 returns true if the OneOf8 holds a value of the eighth case.
+//
+// Type signature:
+//
+//	Is8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Bool
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Is8() bool {
+	return o.index == 7
+}
+
+// As8 returns the value of the eighth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	As8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> Option T8
+//
+// It returns Some with the value if the OneOf8 holds the eighth case, or None otherwise.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) As8() option.Option[T8] {
+	if o.index == 7 {
+		return option.Some(o.v8)
+	}
+	return option.None[T8]()
+}
+
+// Match applies the handler for the case held by the OneOf8.
+//
+// Type signature:
+//
+//	Match :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T1 -> ()) -> (T2 -> ()) -> (T3 -> ()) -> (T4 -> ()) -> (T5 -> ()) -> (T6 -> ()) -> (T7 -> ()) -> (T8 -> ()) -> ()
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the match is exhaustive.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) Match(f1 func(T1), f2 func(T2), f3 func(T3), f4 func(T4), f5 func(T5), f6 func(T6), f7 func(T7), f8 func(T8)) {
+	switch o.index {
+	case 1:
+		f2(o.v2)
+	case 2:
+		f3(o.v3)
+	case 3:
+		f4(o.v4)
+	case 4:
+		f5(o.v5)
+	case 5:
+		f6(o.v6)
+	case 6:
+		f7(o.v7)
+	case 7:
+		f8(o.v8)
+	default:
+		f1(o.v1)
+	}
+}
+
+// Fold8 collapses a OneOf8 into a single value by applying the handler for the case it holds.
+//
+// Type signature:
+//
+//	Fold8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T1 -> U) -> (T2 -> U) -> (T3 -> U) -> (T4 -> U) -> (T5 -> U) -> (T6 -> U) -> (T7 -> U) -> (T8 -> U) -> U
+//
+// Exactly one handler is invoked. A handler must be supplied for every case, so the fold is exhaustive.
+func Fold8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], f1 func(T1) U, f2 func(T2) U, f3 func(T3) U, f4 func(T4) U, f5 func(T5) U, f6 func(T6) U, f7 func(T7) U, f8 func(T8) U) U {
+	switch o.index {
+	case 1:
+		return f2(o.v2)
+	case 2:
+		return f3(o.v3)
+	case 3:
+		return f4(o.v4)
+	case 4:
+		return f5(o.v5)
+	case 5:
+		return f6(o.v6)
+	case 6:
+		return f7(o.v7)
+	case 7:
+		return f8(o.v8)
+	default:
+		return f1(o.v1)
+	}
+}
+
+// Map1Of8 applies a function to the value of the first case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map1Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T1 -> U) -> OneOf8 U T2 T3 T4 T5 T6 T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map1Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T1) U) OneOf8[U, T2, T3, T4, T5, T6, T7, T8] {
+	if o.index == 0 {
+		return OneOf8[U, T2, T3, T4, T5, T6, T7, T8]{index: o.index, v1: fn(o.v1)}
+	}
+	return OneOf8[U, T2, T3, T4, T5, T6, T7, T8]{index: o.index, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6, v7: o.v7, v8: o.v8}
+}
+
+// Map2Of8 applies a function to the value of the second case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map2Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T2 -> U) -> OneOf8 T1 U T3 T4 T5 T6 T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map2Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T2) U) OneOf8[T1, U, T3, T4, T5, T6, T7, T8] {
+	if o.index == 1 {
+		return OneOf8[T1, U, T3, T4, T5, T6, T7, T8]{index: o.index, v2: fn(o.v2)}
+	}
+	return OneOf8[T1, U, T3, T4, T5, T6, T7, T8]{index: o.index, v1: o.v1, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6, v7: o.v7, v8: o.v8}
+}
+
+// Map3Of8 applies a function to the value of the third case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map3Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T3 -> U) -> OneOf8 T1 T2 U T4 T5 T6 T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map3Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T3) U) OneOf8[T1, T2, U, T4, T5, T6, T7, T8] {
+	if o.index == 2 {
+		return OneOf8[T1, T2, U, T4, T5, T6, T7, T8]{index: o.index, v3: fn(o.v3)}
+	}
+	return OneOf8[T1, T2, U, T4, T5, T6, T7, T8]{index: o.index, v1: o.v1, v2: o.v2, v4: o.v4, v5: o.v5, v6: o.v6, v7: o.v7, v8: o.v8}
+}
+
+// Map4Of8 applies a function to the value of the fourth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map4Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T4 -> U) -> OneOf8 T1 T2 T3 U T5 T6 T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map4Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T4) U) OneOf8[T1, T2, T3, U, T5, T6, T7, T8] {
+	if o.index == 3 {
+		return OneOf8[T1, T2, T3, U, T5, T6, T7, T8]{index: o.index, v4: fn(o.v4)}
+	}
+	return OneOf8[T1, T2, T3, U, T5, T6, T7, T8]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v5: o.v5, v6: o.v6, v7: o.v7, v8: o.v8}
+}
+
+// Map5Of8 applies a function to the value of the fifth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map5Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T5 -> U) -> OneOf8 T1 T2 T3 T4 U T6 T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map5Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T5) U) OneOf8[T1, T2, T3, T4, U, T6, T7, T8] {
+	if o.index == 4 {
+		return OneOf8[T1, T2, T3, T4, U, T6, T7, T8]{index: o.index, v5: fn(o.v5)}
+	}
+	return OneOf8[T1, T2, T3, T4, U, T6, T7, T8]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v6: o.v6, v7: o.v7, v8: o.v8}
+}
+
+// Map6Of8 applies a function to the value of the sixth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map6Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T6 -> U) -> OneOf8 T1 T2 T3 T4 T5 U T7 T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map6Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T6) U) OneOf8[T1, T2, T3, T4, T5, U, T7, T8] {
+	if o.index == 5 {
+		return OneOf8[T1, T2, T3, T4, T5, U, T7, T8]{index: o.index, v6: fn(o.v6)}
+	}
+	return OneOf8[T1, T2, T3, T4, T5, U, T7, T8]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5, v7: o.v7, v8: o.v8}
+}
+
+// Map7Of8 applies a function to the value of the seventh case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map7Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T7 -> U) -> OneOf8 T1 T2 T3 T4 T5 T6 U T8
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map7Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T7) U) OneOf8[T1, T2, T3, T4, T5, T6, U, T8] {
+	if o.index == 6 {
+		return OneOf8[T1, T2, T3, T4, T5, T6, U, T8]{index: o.index, v7: fn(o.v7)}
+	}
+	return OneOf8[T1, T2, T3, T4, T5, T6, U, T8]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6, v8: o.v8}
+}
+
+// Map8Of8 applies a function to the value of the eighth case, if the OneOf8 holds it.
+//
+// Type signature:
+//
+//	Map8Of8 :: OneOf8 T1 T2 T3 T4 T5 T6 T7 T8 -> (T8 -> U) -> OneOf8 T1 T2 T3 T4 T5 T6 T7 U
+//
+// If the OneOf8 holds any other case, that value is carried over unchanged.
+func Map8Of8[T1, T2, T3, T4, T5, T6, T7, T8, U any](o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8], fn func(T8) U) OneOf8[T1, T2, T3, T4, T5, T6, T7, U] {
+	if o.index == 7 {
+		return OneOf8[T1, T2, T3, T4, T5, T6, T7, U]{index: o.index, v8: fn(o.v8)}
+	}
+	return OneOf8[T1, T2, T3, T4, T5, T6, T7, U]{index: o.index, v1: o.v1, v2: o.v2, v3: o.v3, v4: o.v4, v5: o.v5, v6: o.v6, v7: o.v7}
+}
+
+// MarshalJSON implements json.Marshaler.
+//
+// The value is encoded as an object with a "tag" discriminator naming the case, from "case1" onwards,
+// and a "value" holding the encoded value of that case, such as {"tag":"case2","value":"x"}.
+func (o OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) MarshalJSON() ([]byte, error) {
+	switch o.index {
+	case 1:
+		return marshalTagged("case2", o.v2)
+	case 2:
+		return marshalTagged("case3", o.v3)
+	case 3:
+		return marshalTagged("case4", o.v4)
+	case 4:
+		return marshalTagged("case5", o.v5)
+	case 5:
+		return marshalTagged("case6", o.v6)
+	case 6:
+		return marshalTagged("case7", o.v7)
+	case 7:
+		return marshalTagged("case8", o.v8)
+	default:
+		return marshalTagged("case1", o.v1)
+	}
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+//
+// It decodes the format produced by MarshalJSON, returning an error if the tag is not one of "case1" to "case8".
+// As with encoding/json, a JSON null leaves the OneOf8 unchanged.
+func (o *OneOf8[T1, T2, T3, T4, T5, T6, T7, T8]) UnmarshalJSON(data []byte) error {
+	tagged, err := unmarshalTagged(data)
+	if err != nil || tagged == nil {
+		return err
+	}
+	switch tagged.Tag {
+	case "case1":
+		var v T1
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case1Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case2":
+		var v T2
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case2Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case3":
+		var v T3
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case3Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case4":
+		var v T4
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case4Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case5":
+		var v T5
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case5Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case6":
+		var v T6
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case6Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case7":
+		var v T7
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case7Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	case "case8":
+		var v T8
+		if err := json.Unmarshal(tagged.Value, &v); err != nil {
+			return err
+		}
+		*o = Case8Of8[T1, T2, T3, T4, T5, T6, T7, T8](v)
+	default:
+		return fmt.Errorf("oneof: unknown tag %q for OneOf8", tagged.Tag)
+	}
+	return nil
+}
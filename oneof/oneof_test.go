@@ -0,0 +1,189 @@
+package oneof_test
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/oneof"
+)
+
+type shape = oneof.OneOf3[int, string, bool]
+
+func TestCaseConstructors(t *testing.T) {
+	cases := []struct {
+		value shape
+		index int
+	}{
+		{oneof.Case1Of3[int, string, bool](1), 1},
+		{oneof.Case2Of3[int, string, bool]("a"), 2},
+		{oneof.Case3Of3[int, string, bool](true), 3},
+		{shape{}, 1},
+	}
+	for _, c := range cases {
+		if got := c.value.Index(); got != c.index {
+			t.Errorf("Index() = %d, want %d", got, c.index)
+		}
+	}
+}
+
+func TestIsAs(t *testing.T) {
+	s := oneof.Case2Of3[int, string, bool]("a")
+	if s.Is1() || !s.Is2() || s.Is3() {
+		t.Errorf("expected only Is2 to be true")
+	}
+	if s.As1().IsSome() || s.As3().IsSome() {
+		t.Errorf("expected As1 and As3 to be None")
+	}
+	if v := s.As2().GetOrNil(); v == nil || *v != "a" {
+		t.Errorf("expected As2 to be Some(\"a\")")
+	}
+}
+
+func TestMatch(t *testing.T) {
+	var got string
+	oneof.Case3Of3[int, string, bool](true).Match(
+		func(int) { got = "int" },
+		func(string) { got = "string" },
+		func(bool) { got = "bool" },
+	)
+	if got != "bool" {
+		t.Errorf("Match() invoked %q handler, want %q", got, "bool")
+	}
+}
+
+func TestFold(t *testing.T) {
+	describe := func(s shape) string {
+		return oneof.Fold3(s,
+			strconv.Itoa,
+			func(s string) string { return "s:" + s },
+			strconv.FormatBool,
+		)
+	}
+	if got := describe(oneof.Case1Of3[int, string, bool](42)); got != "42" {
+		t.Errorf("Fold3() = %q, want %q", got, "42")
+	}
+	if got := describe(oneof.Case2Of3[int, string, bool]("x")); got != "s:x" {
+		t.Errorf("Fold3() = %q, want %q", got, "s:x")
+	}
+}
+
+func TestMap(t *testing.T) {
+	s := oneof.Map1Of3(oneof.Case1Of3[int, string, bool](21), func(n int) float64 { return float64(n) * 2 })
+	if v := s.As1().GetOrNil(); v == nil || *v != 42 {
+		t.Errorf("expected mapped first case to be 42")
+	}
+	o := oneof.Map1Of3(oneof.Case3Of3[int, string, bool](true), func(n int) float64 { return float64(n) })
+	if v := o.As3().GetOrNil(); v == nil || !*v {
+		t.Errorf("expected other case to be carried over unchanged")
+	}
+}
+
+func TestOneOf8(t *testing.T) {
+	type eight = oneof.OneOf8[int, int8, int16, int32, int64, uint, string, bool]
+	e := oneof.Case7Of8[int, int8, int16, int32, int64, uint, string, bool]("seven")
+	if e.Index() != 7 || !e.Is7() || e.Is8() {
+		t.Errorf("expected seventh case, got index %d", e.Index())
+	}
+	mapped := oneof.Map7Of8(e, func(s string) int { return len(s) })
+	got := oneof.Fold8(mapped,
+		func(int) int { return -1 }, func(int8) int { return -1 }, func(int16) int { return -1 },
+		func(int32) int { return -1 }, func(int64) int { return -1 }, func(uint) int { return -1 },
+		func(n int) int { return n }, func(bool) int { return -1 },
+	)
+	if got != 5 {
+		t.Errorf("Fold8() = %d, want %d", got, 5)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil || string(data) != `{"tag":"case7","value":"seven"}` {
+		t.Errorf("unexpected encoding: %s, %v", data, err)
+	}
+	var decoded eight
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != e {
+		t.Errorf("unexpected decoding: %v, %v", decoded, err)
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	roundTrip(t,
+		oneof.Case1Of3[int, string, bool](1),
+		oneof.Case2Of3[int, string, bool]("a"),
+		oneof.Case3Of3[int, string, bool](true),
+	)
+	roundTrip(t,
+		oneof.Case1Of4[int, string, bool, float64](1),
+		oneof.Case2Of4[int, string, bool, float64]("a"),
+		oneof.Case3Of4[int, string, bool, float64](true),
+		oneof.Case4Of4[int, string, bool, float64](1.5),
+	)
+	roundTrip(t,
+		oneof.Case1Of5[int, string, bool, float64, uint](1),
+		oneof.Case2Of5[int, string, bool, float64, uint]("a"),
+		oneof.Case3Of5[int, string, bool, float64, uint](true),
+		oneof.Case4Of5[int, string, bool, float64, uint](1.5),
+		oneof.Case5Of5[int, string, bool, float64, uint](5),
+	)
+	roundTrip(t,
+		oneof.Case1Of6[int, string, bool, float64, uint, int8](1),
+		oneof.Case2Of6[int, string, bool, float64, uint, int8]("a"),
+		oneof.Case3Of6[int, string, bool, float64, uint, int8](true),
+		oneof.Case4Of6[int, string, bool, float64, uint, int8](1.5),
+		oneof.Case5Of6[int, string, bool, float64, uint, int8](5),
+		oneof.Case6Of6[int, string, bool, float64, uint, int8](-6),
+	)
+	roundTrip(t,
+		oneof.Case1Of7[int, string, bool, float64, uint, int8, [2]int](1),
+		oneof.Case2Of7[int, string, bool, float64, uint, int8, [2]int]("a"),
+		oneof.Case3Of7[int, string, bool, float64, uint, int8, [2]int](true),
+		oneof.Case4Of7[int, string, bool, float64, uint, int8, [2]int](1.5),
+		oneof.Case5Of7[int, string, bool, float64, uint, int8, [2]int](5),
+		oneof.Case6Of7[int, string, bool, float64, uint, int8, [2]int](-6),
+		oneof.Case7Of7[int, string, bool, float64, uint, int8, [2]int]([2]int{7, 7}),
+	)
+}
+
+func roundTrip[T comparable](t *testing.T, values ...T) {
+	t.Helper()
+	for i, v := range values {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("Marshal() error = %v", err)
+		}
+		if want := `"tag":"case` + strconv.Itoa(i+1) + `"`; !strings.Contains(string(data), want) {
+			t.Errorf("Marshal() = %s, want tag %s", data, want)
+		}
+		var decoded T
+		if err := json.Unmarshal(data, &decoded); err != nil || decoded != v {
+			t.Errorf("round trip of %s = %v, %v", data, decoded, err)
+		}
+	}
+}
+
+func TestUnmarshalJSONNull(t *testing.T) {
+	s := oneof.Case2Of3[int, string, bool]("kept")
+	if err := json.Unmarshal([]byte(`null`), &s); err != nil || s != oneof.Case2Of3[int, string, bool]("kept") {
+		t.Errorf("Unmarshal(null) = %v, %v; want the value unchanged", s, err)
+	}
+	var fields struct{ Shape *shape }
+	if err := json.Unmarshal([]byte(`{"Shape":null}`), &fields); err != nil || fields.Shape != nil {
+		t.Errorf("Unmarshal(null field) = %v, %v", fields.Shape, err)
+	}
+}
+
+func TestUnmarshalJSONErrors(t *testing.T) {
+	var s shape
+	if err := json.Unmarshal([]byte(`{"tag":"case4","value":1}`), &s); err == nil {
+		t.Errorf("expected error for unknown tag")
+	}
+	if err := json.Unmarshal([]byte(`{"tag":"case1","value":"x"}`), &s); err == nil {
+		t.Errorf("expected error for mismatched value type")
+	}
+	if err := json.Unmarshal([]byte(`{"tag":1,"value":1}`), &s); err == nil {
+		t.Errorf("expected error for numeric tag")
+	}
+	if err := json.Unmarshal([]byte(`[]`), &s); err == nil {
+		t.Errorf("expected error for malformed input")
+	}
+}
@@ -156,7 +156,7 @@ func (opt Option[T]) ToSlice() []T {
 //
 //	Zip :: Option a -> Option b -> Option (a, b)
 //
-// If either Option is None, it returns None.
+// If either Option is None, it returns None. To combine more Options, or into a type other than a pair, use Map2 to Map5.
 func Zip[T, U any](opt1 Option[T], opt2 Option[U]) Option[struct {
 	First  T
 	Second U
@@ -264,6 +264,8 @@ func Try[T any](fn func() (T, error)) Option[T] {
 //
 // It compares the values if both Options are Some, using reflect.DeepEqual to handle complex types.
 // If both Options are None, it returns true. Otherwise, it returns false.
+//
+// Prefer Equal or EqualFunc in hot paths, as they avoid reflection.
 func (opt Option[T]) Equals(other Option[T]) bool {
 	if opt.isSome != other.isSome {
 		return false
@@ -275,3 +277,43 @@ func (opt Option[T]) Equals(other Option[T]) bool {
 
 	return reflect.DeepEqual(opt.value, other.value) // T and T are not comparable with ==
 }
+
+// Tap runs a side effect on the value inside the Option, if it exists (Some).
+//
+// Type signature:
+//
+//	Tap :: Option a -> (a -> ()) -> Option a
+//
+// It returns the Option unchanged, allowing the side effect to be inserted into a chain.
+func (opt Option[T]) Tap(fn func(T)) Option[T] {
+	if opt.isSome {
+		fn(opt.value)
+	}
+	return opt
+}
+
+// TapNone runs a side effect if the Option contains no value (None).
+//
+// Type signature:
+//
+//	TapNone :: Option a -> (() -> ()) -> Option a
+//
+// It returns the Option unchanged, allowing the side effect to be inserted into a chain.
+func (opt Option[T]) TapNone(fn func()) Option[T] {
+	if !opt.isSome {
+		fn()
+	}
+	return opt
+}
+
+// Inspect runs a side effect on the Option itself, whether it is Some or None.
+//
+// Type signature:
+//
+//	Inspect :: Option a -> (Option a -> ()) -> Option a
+//
+// It returns the Option unchanged, allowing the side effect to be inserted into a chain.
+func (opt Option[T]) Inspect(fn func(Option[T])) Option[T] {
+	fn(opt)
+	return opt
+}
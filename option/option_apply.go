@@ -0,0 +1,71 @@
+package option
+
+// Apply applies a function wrapped in an Option to a value wrapped in an Option.
+//
+// Type signature:
+//
+//	Apply :: Option (a -> b) -> Option a -> Option b
+//
+// If both Options are Some, it returns Some with the result of the function. Otherwise, it returns None.
+func Apply[T, U any](fn Option[func(T) U], opt Option[T]) Option[U] {
+	if !fn.isSome || !opt.isSome {
+		return None[U]()
+	}
+	return Some(fn.value(opt.value))
+}
+
+// Map2 combines 2 Options with a function of 2 arguments.
+//
+// Type signature:
+//
+//	Map2 :: Option a -> Option b -> (a -> b -> r) -> Option r
+//
+// If every Option is Some, it returns Some with the result of fn. Otherwise, it returns None.
+func Map2[T1, T2, U any](o1 Option[T1], o2 Option[T2], fn func(T1, T2) U) Option[U] {
+	if !o1.isSome || !o2.isSome {
+		return None[U]()
+	}
+	return Some(fn(o1.value, o2.value))
+}
+
+// Map3 combines 3 Options with a function of 3 arguments.
+//
+// Type signature:
+//
+//	Map3 :: Option a -> Option b -> Option c -> (a -> b -> c -> r) -> Option r
+//
+// If every Option is Some, it returns Some with the result of fn. Otherwise, it returns None.
+func Map3[T1, T2, T3, U any](o1 Option[T1], o2 Option[T2], o3 Option[T3], fn func(T1, T2, T3) U) Option[U] {
+	if !o1.isSome || !o2.isSome || !o3.isSome {
+		return None[U]()
+	}
+	return Some(fn(o1.value, o2.value, o3.value))
+}
+
+// Map4 combines 4 Options with a function of 4 arguments.
+//
+// Type signature:
+//
+//	Map4 :: Option a -> Option b -> Option c -> Option d -> (a -> b -> c -> d -> r) -> Option r
+//
+// If every Option is Some, it returns Some with the result of fn. Otherwise, it returns None.
+func Map4[T1, T2, T3, T4, U any](o1 Option[T1], o2 Option[T2], o3 Option[T3], o4 Option[T4], fn func(T1, T2, T3, T4) U) Option[U] {
+	if !o1.isSome || !o2.isSome || !o3.isSome || !o4.isSome {
+		return None[U]()
+	}
+	return Some(fn(o1.value, o2.value, o3.value, o4.value))
+}
+
+// Map5 combines 5 Options with a function of 5 arguments.
+//
+// Type signature:
+//
+//	Map5 :: Option a -> Option b -> Option c -> Option d -> Option e -> (a -> b -> c -> d -> e -> r) -> Option r
+//
+// If every Option is Some, it returns Some with the result of fn. Otherwise, it returns None.
+func Map5[T1, T2, T3, T4, T5, U any](o1 Option[T1], o2 Option[T2], o3 Option[T3], o4 Option[T4], o5 Option[T5], fn func(T1, T2, T3, T4, T5) U) Option[U] {
+	if !o1.isSome || !o2.isSome || !o3.isSome || !o4.isSome || !o5.isSome {
+		return None[U]()
+	}
+	return Some(fn(o1.value, o2.value, o3.value, o4.value, o5.value))
+}
@@ -0,0 +1,64 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestApply(t *testing.T) {
+	double := option.Some(func(x int) int { return x * 2 })
+	if got := option.Apply(double, option.Some(21)); !option.Equal(got, option.Some(42)) {
+		t.Errorf("expected Some(42), got %v", got)
+	}
+	if got := option.Apply(double, option.None[int]()); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+	if got := option.Apply(option.None[func(int) int](), option.Some(21)); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestMapN(t *testing.T) {
+	sum := func(xs ...int) int {
+		total := 0
+		for _, x := range xs {
+			total += x
+		}
+		return total
+	}
+	one := option.Some(1)
+
+	got := []option.Option[int]{
+		option.Map2(one, one, func(a, b int) int { return sum(a, b) }),
+		option.Map3(one, one, one, func(a, b, c int) int { return sum(a, b, c) }),
+		option.Map4(one, one, one, one, func(a, b, c, d int) int { return sum(a, b, c, d) }),
+		option.Map5(one, one, one, one, one, func(a, b, c, d, e int) int { return sum(a, b, c, d, e) }),
+	}
+	for i, opt := range got {
+		if want := option.Some(i + 2); !option.Equal(opt, want) {
+			t.Errorf("Map%d: expected %v, got %v", i+2, want, opt)
+		}
+	}
+
+	none := option.None[int]()
+	if got := option.Map3(one, none, one, func(a, b, c int) int { return sum(a, b, c) }); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+	if got := option.Map5(one, one, one, one, none, func(a, b, c, d, e int) int { return sum(a, b, c, d, e) }); got.IsSome() {
+		t.Errorf("expected None, got %v", got)
+	}
+}
+
+func TestMap2MixedTypes(t *testing.T) {
+	type user struct {
+		Name string
+		Age  int
+	}
+	got := option.Map2(option.Some("ada"), option.Some(36), func(name string, age int) user {
+		return user{Name: name, Age: age}
+	})
+	if !option.Equal(got, option.Some(user{Name: "ada", Age: 36})) {
+		t.Errorf("expected Some(user), got %v", got)
+	}
+}
@@ -0,0 +1,64 @@
+package option
+
+import "cmp"
+
+// Equal reports whether two Option values of a comparable type are equal, without reflection.
+//
+// Type signature:
+//
+//	Equal :: Option a -> Option a -> Bool
+//
+// Two Options are equal if both are None, or both are Some with values that are equal under ==.
+// Options of a comparable type are themselves comparable, so they can also be used as map keys.
+func Equal[T comparable](a, b Option[T]) bool {
+	return a.isSome == b.isSome && (!a.isSome || a.value == b.value)
+}
+
+// EqualFunc reports whether two Option values are equal, using eq to compare their values.
+//
+// Type signature:
+//
+//	EqualFunc :: Option a -> Option a -> ((a, a) -> Bool) -> Bool
+//
+// Two Options are equal if both are None, or both are Some and eq returns true for their values.
+// eq is only called when both Options are Some.
+func EqualFunc[T any](a, b Option[T], eq func(T, T) bool) bool {
+	if a.isSome != b.isSome {
+		return false
+	}
+	return !a.isSome || eq(a.value, b.value)
+}
+
+// Compare compares two Option values of an ordered type.
+//
+// Type signature:
+//
+//	Compare :: Option a -> Option a -> Int
+//
+// None sorts before any Some, and two Some values are ordered by cmp.Compare.
+// It returns -1 if a is less than b, 0 if they are equal and +1 if a is greater than b,
+// so it can be passed to slices.SortFunc.
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return CompareFunc(a, b, cmp.Compare[T])
+}
+
+// CompareFunc compares two Option values, using compare to order their values.
+//
+// Type signature:
+//
+//	CompareFunc :: Option a -> Option a -> ((a, a) -> Int) -> Int
+//
+// None sorts before any Some, and two Some values are ordered by compare.
+// compare is only called when both Options are Some.
+func CompareFunc[T any](a, b Option[T], compare func(T, T) int) int {
+	switch {
+	case a.isSome && b.isSome:
+		return compare(a.value, b.value)
+	case a.isSome:
+		return 1
+	case b.isSome:
+		return -1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,69 @@
+package option_test
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestEqual(t *testing.T) {
+	if !option.Equal(option.Some(1), option.Some(1)) {
+		t.Errorf("expected equal Some values to be equal")
+	}
+	if option.Equal(option.Some(1), option.Some(2)) {
+		t.Errorf("expected different Some values to be unequal")
+	}
+	if option.Equal(option.Some(0), option.None[int]()) {
+		t.Errorf("expected Some(0) and None to be unequal")
+	}
+	if !option.Equal(option.None[int](), option.None[int]()) {
+		t.Errorf("expected None values to be equal")
+	}
+	if option.Equal(option.Some(math.NaN()), option.Some(math.NaN())) {
+		t.Errorf("expected NaN values to be unequal")
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	eq := func(a, b []int) bool { return slices.Equal(a, b) }
+	if !option.EqualFunc(option.Some([]int{1, 2}), option.Some([]int{1, 2}), eq) {
+		t.Errorf("expected equal Some values to be equal")
+	}
+	if option.EqualFunc(option.Some([]int{1}), option.None[[]int](), eq) {
+		t.Errorf("expected Some and None to be unequal")
+	}
+}
+
+func TestCompare(t *testing.T) {
+	opts := []option.Option[int]{option.Some(3), option.None[int](), option.Some(1)}
+	slices.SortFunc(opts, option.Compare[int])
+	want := []option.Option[int]{option.None[int](), option.Some(1), option.Some(3)}
+	if !slices.Equal(opts, want) {
+		t.Errorf("expected %v, got %v", want, opts)
+	}
+	if option.Compare(option.None[int](), option.None[int]()) != 0 {
+		t.Errorf("expected None values to compare equal")
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	byLen := func(a, b string) int { return len(a) - len(b) }
+	if option.CompareFunc(option.Some("aa"), option.Some("b"), byLen) <= 0 {
+		t.Errorf("expected longer string to sort after shorter string")
+	}
+	if option.CompareFunc(option.Some(""), option.None[string](), byLen) != 1 {
+		t.Errorf("expected Some to sort after None")
+	}
+}
+
+func TestOptionAsMapKey(t *testing.T) {
+	counts := map[option.Option[string]]int{}
+	counts[option.Some("a")]++
+	counts[option.Some("a")]++
+	counts[option.None[string]()]++
+	if counts[option.Some("a")] != 2 || counts[option.None[string]()] != 1 {
+		t.Errorf("unexpected counts: %v", counts)
+	}
+}
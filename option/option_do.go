@@ -0,0 +1,87 @@
+package option
+
+// Scope is the handle passed to a Do block, used to unwrap Options inside it.
+//
+// A Scope is only valid on the goroutine running its Do block and only until the block returns.
+type Scope struct {
+	done bool
+}
+
+// exit is the value Get panics with to leave a Do block early. It records the Scope it belongs to,
+// so that nested Do blocks only recover their own exits.
+type exit struct {
+	scope *Scope
+}
+
+// Do runs a block of imperative-looking code with monadic semantics.
+//
+// Type signature:
+//
+//	Do :: (Scope -> a) -> Option a
+//
+// Inside the block, Get unwraps Options. The first None passed to Get stops the block, and Do returns None;
+// otherwise Do returns Some with the block's value:
+//
+//	name := option.Do(func(s *option.Scope) string {
+//		user := option.Get(s, findUser(id))
+//		team := option.Get(s, findTeam(user.TeamID))
+//		return user.Name + " (" + team.Name + ")"
+//	})
+//
+// Early exits are implemented with a panic that Do always recovers. Any other panic raised by the block is propagated.
+func Do[T any](fn func(s *Scope) T) (res Option[T]) {
+	s := &Scope{}
+	defer func() {
+		s.done = true
+		if r := recover(); r != nil {
+			if e, ok := r.(exit); ok && e.scope == s {
+				res = None[T]()
+				return
+			}
+			panic(r)
+		}
+	}()
+	return Some(fn(s))
+}
+
+// Get unwraps an Option inside a Do block.
+//
+// Type signature:
+//
+//	Get :: Scope -> Option a -> a
+//
+// It returns the value of a Some. If the Option is None, the enclosing Do block stops and returns None.
+// Get is a function rather than a method of Scope because Go methods cannot have type parameters.
+//
+// Get panics if the Scope is used after its Do block has returned.
+func Get[T any](s *Scope, o Option[T]) T {
+	if !o.isSome {
+		s.Fail()
+	}
+	return o.value
+}
+
+// Guard stops the enclosing Do block, which returns None, if cond is false.
+//
+// Type signature:
+//
+//	Guard :: Scope -> Bool -> ()
+func (s *Scope) Guard(cond bool) {
+	if !cond {
+		s.Fail()
+	}
+}
+
+// Fail stops the enclosing Do block, which returns None.
+//
+// Type signature:
+//
+//	Fail :: Scope -> ()
+//
+// Fail panics if the Scope is used after its Do block has returned.
+func (s *Scope) Fail() {
+	if s.done {
+		panic("option: Scope used after its Do block returned")
+	}
+	panic(exit{scope: s})
+}
@@ -0,0 +1,67 @@
+package option_test
+
+import (
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestDoSome(t *testing.T) {
+	opt := option.Do(func(s *option.Scope) int {
+		a := option.Get(s, option.Some(1))
+		b := option.Get(s, option.Some(2))
+		s.Guard(a < b)
+		return a + b
+	})
+	if opt.IsNone() || *opt.GetOrNil() != 3 {
+		t.Errorf("expected Some(3), got %v", opt)
+	}
+}
+
+func TestDoShortCircuits(t *testing.T) {
+	reached := false
+	opt := option.Do(func(s *option.Scope) int {
+		a := option.Get(s, option.None[int]())
+		reached = true
+		return a
+	})
+	if opt.IsSome() {
+		t.Errorf("expected None, got %v", opt)
+	}
+	if reached {
+		t.Errorf("expected the block to stop at the first None")
+	}
+}
+
+func TestDoGuard(t *testing.T) {
+	opt := option.Do(func(s *option.Scope) int {
+		s.Guard(false)
+		return 1
+	})
+	if opt.IsSome() {
+		t.Errorf("expected None, got %v", opt)
+	}
+}
+
+func TestDoNested(t *testing.T) {
+	opt := option.Do(func(outer *option.Scope) int {
+		inner := option.Do(func(s *option.Scope) int {
+			outer.Fail()
+			return 0
+		})
+		t.Errorf("expected the outer block to stop, inner returned %v", inner)
+		return 1
+	})
+	if opt.IsSome() {
+		t.Errorf("expected None, got %v", opt)
+	}
+}
+
+func TestDoPropagatesOtherPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("expected panic \"boom\", got %v", r)
+		}
+	}()
+	option.Do(func(s *option.Scope) int { panic("boom") })
+}
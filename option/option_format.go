@@ -0,0 +1,37 @@
+package option
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// String returns the Option rendered as Some(value) or None.
+//
+// Type signature:
+//
+//	String :: Option a -> String
+func (opt Option[T]) String() string {
+	return fmt.Sprint(opt)
+}
+
+// Format implements fmt.Formatter.
+//
+// The Option is rendered as Some(value) or None, with the verb and flags applied to the value.
+// %#v renders a Go-syntax constructor expression, such as option.Some[int](3).
+func (opt Option[T]) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('#') && opt.isSome:
+		fmt.Fprintf(s, "option.Some[%s](%#v)", typeName[T](), opt.value)
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "option.None[%s]()", typeName[T]())
+	case opt.isSome:
+		fmt.Fprintf(s, "Some("+fmt.FormatString(s, verb)+")", opt.value)
+	default:
+		io.WriteString(s, "None")
+	}
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
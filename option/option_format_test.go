@@ -0,0 +1,40 @@
+package option_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		opt    any
+		want   string
+	}{
+		{"%v", option.Some(3), "Some(3)"},
+		{"%v", option.None[int](), "None"},
+		{"%s", option.Some("x"), "Some(x)"},
+		{"%q", option.Some("x"), `Some("x")`},
+		{"%03d", option.Some(7), "Some(007)"},
+		{"%+v", option.Some(struct{ A int }{1}), "Some({A:1})"},
+		{"%#v", option.Some(3), "option.Some[int](3)"},
+		{"%#v", option.Some("x"), `option.Some[string]("x")`},
+		{"%#v", option.None[error](), "option.None[error]()"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.opt); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := option.Some(option.Some(3)).String(); got != "Some(Some(3))" {
+		t.Errorf("String() = %q, want %q", got, "Some(Some(3))")
+	}
+	if got := fmt.Sprint([]option.Option[int]{option.Some(1), option.None[int]()}); got != "[Some(1) None]" {
+		t.Errorf("Sprint() = %q, want %q", got, "[Some(1) None]")
+	}
+}
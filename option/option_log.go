@@ -0,0 +1,17 @@
+package option
+
+import "log/slog"
+
+// LogValue implements slog.LogValuer.
+//
+// Type signature:
+//
+//	LogValue :: Option a -> slog.Value
+//
+// Some is logged as the value it contains, and None is logged as the string "none".
+func (opt Option[T]) LogValue() slog.Value {
+	if opt.isSome {
+		return slog.AnyValue(opt.value)
+	}
+	return slog.StringValue("none")
+}
@@ -0,0 +1,42 @@
+package option_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func logLine(opt any) string {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", "opt", opt)
+	return strings.TrimSpace(buf.String())
+}
+
+func TestLogValueSome(t *testing.T) {
+	if got := logLine(option.Some(42)); got != "msg=msg opt=42" {
+		t.Errorf("unexpected log output: %q", got)
+	}
+}
+
+func TestLogValueNone(t *testing.T) {
+	if got := logLine(option.None[int]()); got != "msg=msg opt=none" {
+		t.Errorf("unexpected log output: %q", got)
+	}
+}
+
+func TestLogValueNested(t *testing.T) {
+	if got := logLine(option.Some(option.Some("x"))); got != "msg=msg opt=x" {
+		t.Errorf("unexpected log output: %q", got)
+	}
+}
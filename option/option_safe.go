@@ -0,0 +1,33 @@
+package option
+
+// TrySafe applies a function that returns a value and an error, recovering from panics.
+//
+// Type signature:
+//
+//	TrySafe :: (() -> (a, Error)) -> Option a
+//
+// It behaves like Try, but if fn panics the panic is recovered and converted into None.
+func TrySafe[T any](fn func() (T, error)) (opt Option[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			opt = None[T]()
+		}
+	}()
+	return Try(fn)
+}
+
+// TryCatch runs a function that may panic, capturing its value in an Option.
+//
+// Type signature:
+//
+//	TryCatch :: (() -> a) -> Option a
+//
+// It returns Some with the value returned by fn, or None if fn panics.
+func TryCatch[T any](fn func() T) (opt Option[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			opt = None[T]()
+		}
+	}()
+	return Some(fn())
+}
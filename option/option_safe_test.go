@@ -0,0 +1,43 @@
+package option_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+)
+
+func TestTrySafeSome(t *testing.T) {
+	opt := option.TrySafe(func() (int, error) { return 42, nil })
+	if opt.IsNone() || *opt.GetOrNil() != 42 {
+		t.Errorf("expected Some result")
+	}
+}
+
+func TestTrySafeErr(t *testing.T) {
+	opt := option.TrySafe(func() (int, error) { return 0, errors.New("test error") })
+	if opt.IsSome() {
+		t.Errorf("expected None result")
+	}
+}
+
+func TestTrySafePanic(t *testing.T) {
+	opt := option.TrySafe(func() (int, error) { panic("boom") })
+	if opt.IsSome() {
+		t.Errorf("expected None result")
+	}
+}
+
+func TestTryCatchSome(t *testing.T) {
+	opt := option.TryCatch(func() int { return 42 })
+	if opt.IsNone() || *opt.GetOrNil() != 42 {
+		t.Errorf("expected Some result")
+	}
+}
+
+func TestTryCatchPanic(t *testing.T) {
+	opt := option.TryCatch(func() int { panic("boom") })
+	if opt.IsSome() {
+		t.Errorf("expected None result")
+	}
+}
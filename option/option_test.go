@@ -391,3 +391,40 @@ func TestNequalsBothSome(t *testing.T) {
 		t.Errorf("expected false")
 	}
 }
+
+func TestTapSome(t *testing.T) {
+	var seen int
+	opt := option.Some(42).Tap(func(x int) { seen = x })
+	if seen != 42 || !opt.Equals(option.Some(42)) {
+		t.Errorf("expected Tap to see 42 and return the Option unchanged")
+	}
+}
+
+func TestTapNone(t *testing.T) {
+	called := false
+	opt := option.None[int]().Tap(func(int) { called = true })
+	if called || opt.IsSome() {
+		t.Errorf("expected Tap not to run on None")
+	}
+}
+
+func TestTapNoneOnNone(t *testing.T) {
+	called := false
+	option.None[int]().TapNone(func() { called = true })
+	if !called {
+		t.Errorf("expected TapNone to run on None")
+	}
+	called = false
+	option.Some(42).TapNone(func() { called = true })
+	if called {
+		t.Errorf("expected TapNone not to run on Some")
+	}
+}
+
+func TestInspect(t *testing.T) {
+	var seen option.Option[int]
+	opt := option.Some(42).Inspect(func(o option.Option[int]) { seen = o })
+	if !seen.Equals(opt) {
+		t.Errorf("expected Inspect to see the Option")
+	}
+}
@@ -0,0 +1,256 @@
+package parse
+
+import (
+	"errors"
+
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// consumed reports whether a failure happened after consuming input from in.
+// Errors that are not parse Errors are always treated as having consumed input, so they are never recovered from.
+func consumed(err error, in Input) bool {
+	var perr *Error
+	return !errors.As(err, &perr) || perr.Pos.Offset > in.Pos.Offset
+}
+
+// hintOf returns the failure that stopped a Parser without consuming input, to be kept as a hint.
+func hintOf(err error) *Error {
+	var perr *Error
+	errors.As(err, &perr)
+	return perr
+}
+
+// Seq runs each Parser in turn, collecting their values.
+//
+// Type signature:
+//
+//	Seq :: [Parser a] -> Parser [a]
+//
+// It fails with the first failure. To sequence Parsers of different types, use Map2, Map3 or Bind.
+func Seq[T any](ps ...Parser[T]) Parser[[]T] {
+	return func(in Input) result.Result[Reply[[]T]] {
+		vals := make([]T, 0, len(ps))
+		var hint *Error
+		for _, p := range ps {
+			res := withHint(p(in), hint)
+			r, err := res.Unpack()
+			if res.IsErr() {
+				return result.Err[Reply[[]T]](err)
+			}
+			vals = append(vals, r.Value)
+			in, hint = r.Input, r.hint
+		}
+		return result.Ok(Reply[[]T]{Value: vals, Input: in, hint: hint})
+	}
+}
+
+// Map2 runs two Parsers in turn and combines their values with fn.
+//
+// Type signature:
+//
+//	Map2 :: Parser a -> Parser b -> (a -> b -> c) -> Parser c
+func Map2[A, B, C any](pa Parser[A], pb Parser[B], fn func(A, B) C) Parser[C] {
+	return Bind(pa, func(a A) Parser[C] {
+		return Map(pb, func(b B) C { return fn(a, b) })
+	})
+}
+
+// Map3 runs three Parsers in turn and combines their values with fn.
+//
+// Type signature:
+//
+//	Map3 :: Parser a -> Parser b -> Parser c -> (a -> b -> c -> d) -> Parser d
+func Map3[A, B, C, D any](pa Parser[A], pb Parser[B], pc Parser[C], fn func(A, B, C) D) Parser[D] {
+	return Bind(pa, func(a A) Parser[D] {
+		return Map2(pb, pc, func(b B, c C) D { return fn(a, b, c) })
+	})
+}
+
+// KeepLeft runs two Parsers in turn, keeping the value of the first.
+//
+// Type signature:
+//
+//	KeepLeft :: Parser a -> Parser b -> Parser a
+func KeepLeft[T, U any](p Parser[T], q Parser[U]) Parser[T] {
+	return Map2(p, q, func(t T, _ U) T { return t })
+}
+
+// KeepRight runs two Parsers in turn, keeping the value of the second.
+//
+// Type signature:
+//
+//	KeepRight :: Parser a -> Parser b -> Parser b
+func KeepRight[T, U any](p Parser[T], q Parser[U]) Parser[U] {
+	return Map2(p, q, func(_ T, u U) U { return u })
+}
+
+// Between runs open, p and close in turn, keeping the value of p.
+//
+// Type signature:
+//
+//	Between :: Parser o -> Parser a -> Parser c -> Parser a
+func Between[O, T, C any](open Parser[O], p Parser[T], close Parser[C]) Parser[T] {
+	return KeepRight(open, KeepLeft(p, close))
+}
+
+// Alt tries each Parser in turn from the same Input, returning the first success.
+//
+// Type signature:
+//
+//	Alt :: [Parser a] -> Parser a
+//
+// Alt always backtracks. If every Parser fails, it returns the failure that got furthest into the input,
+// merging the expected tokens of failures at the same position.
+func Alt[T any](ps ...Parser[T]) Parser[T] {
+	return func(in Input) result.Result[Reply[T]] {
+		var failure error = expected(in)
+		for _, p := range ps {
+			res := p(in)
+			if res.IsOk() {
+				var perr *Error
+				if errors.As(failure, &perr) {
+					return withHint(res, perr)
+				}
+				return res
+			}
+			_, err := res.Unpack()
+			failure = furthest(failure, err)
+		}
+		return result.Err[Reply[T]](failure)
+	}
+}
+
+// Optional runs p, returning None instead of failing if p fails without consuming input.
+//
+// Type signature:
+//
+//	Optional :: Parser a -> Parser (Option a)
+//
+// If p fails after consuming input, Optional fails too, so that errors inside a partly matched p are reported.
+func Optional[T any](p Parser[T]) Parser[option.Option[T]] {
+	return func(in Input) result.Result[Reply[option.Option[T]]] {
+		res := p(in)
+		r, err := res.Unpack()
+		switch {
+		case res.IsOk():
+			return result.Ok(Reply[option.Option[T]]{Value: option.Some(r.Value), Input: r.Input, hint: r.hint})
+		case consumed(err, in):
+			return result.Err[Reply[option.Option[T]]](err)
+		}
+		return result.Ok(Reply[option.Option[T]]{Value: option.None[T](), Input: in, hint: hintOf(err)})
+	}
+}
+
+// Many runs p as many times as it succeeds, collecting its values.
+//
+// Type signature:
+//
+//	Many :: Parser a -> Parser [a]
+//
+// It stops when p fails without consuming input, or succeeds without consuming any.
+// If p fails after consuming input, Many fails too.
+func Many[T any](p Parser[T]) Parser[[]T] {
+	return func(in Input) result.Result[Reply[[]T]] {
+		var vals []T
+		var hint *Error
+		for {
+			res := withHint(p(in), hint)
+			r, err := res.Unpack()
+			if res.IsErr() {
+				if consumed(err, in) {
+					return result.Err[Reply[[]T]](err)
+				}
+				return result.Ok(Reply[[]T]{Value: vals, Input: in, hint: hintOf(err)})
+			}
+			if r.Pos.Offset == in.Pos.Offset {
+				return result.Ok(Reply[[]T]{Value: vals, Input: in, hint: r.hint})
+			}
+			vals = append(vals, r.Value)
+			in, hint = r.Input, r.hint
+		}
+	}
+}
+
+// Many1 runs p as many times as it succeeds, like Many, but requires it to succeed at least once.
+//
+// Type signature:
+//
+//	Many1 :: Parser a -> Parser [a]
+func Many1[T any](p Parser[T]) Parser[[]T] {
+	return Map2(p, Many(p), prepend[T])
+}
+
+// SepBy parses zero or more values with p, separated by sep.
+//
+// Type signature:
+//
+//	SepBy :: Parser a -> Parser s -> Parser [a]
+//
+// A separator that is not followed by a value is an error.
+func SepBy[T, S any](p Parser[T], sep Parser[S]) Parser[[]T] {
+	return Map(Optional(SepBy1(p, sep)), func(o option.Option[[]T]) []T {
+		return o.GetOrElse(func() []T { return nil })
+	})
+}
+
+// SepBy1 parses one or more values with p, separated by sep.
+//
+// Type signature:
+//
+//	SepBy1 :: Parser a -> Parser s -> Parser [a]
+//
+// A separator that is not followed by a value is an error.
+func SepBy1[T, S any](p Parser[T], sep Parser[S]) Parser[[]T] {
+	return Map2(p, Many(KeepRight(sep, p)), prepend[T])
+}
+
+func prepend[T any](head T, tail []T) []T {
+	return append([]T{head}, tail...)
+}
+
+// Chain parses one or more values with p, separated by op, and combines them from left to right
+// with the functions op returns.
+//
+// Type signature:
+//
+//	Chain :: Parser a -> Parser (a -> a -> a) -> Parser a
+//
+// It parses left-associative operators, so "1-2-3" with a subtraction op evaluates as (1-2)-3.
+func Chain[T any](p Parser[T], op Parser[func(T, T) T]) Parser[T] {
+	step := Map2(op, p, func(f func(T, T) T, rhs T) func(T) T {
+		return func(lhs T) T { return f(lhs, rhs) }
+	})
+	return Map2(p, Many(step), func(acc T, steps []func(T) T) T {
+		for _, s := range steps {
+			acc = s(acc)
+		}
+		return acc
+	})
+}
+
+// ChainRight parses one or more values with p, separated by op, and combines them from right to left
+// with the functions op returns.
+//
+// Type signature:
+//
+//	ChainRight :: Parser a -> Parser (a -> a -> a) -> Parser a
+//
+// It parses right-associative operators, so "2^3^2" with an exponent op evaluates as 2^(3^2).
+func ChainRight[T any](p Parser[T], op Parser[func(T, T) T]) Parser[T] {
+	type step struct {
+		f   func(T, T) T
+		rhs T
+	}
+	return Map2(p, Many(Map2(op, p, func(f func(T, T) T, rhs T) step { return step{f, rhs} })),
+		func(first T, steps []step) T {
+			if len(steps) == 0 {
+				return first
+			}
+			acc := steps[len(steps)-1].rhs
+			for i := len(steps) - 1; i > 0; i-- {
+				acc = steps[i].f(steps[i-1].rhs, acc)
+			}
+			return steps[0].f(first, acc)
+		})
+}
@@ -0,0 +1,35 @@
+/*
+Package parse provides parser combinators built on Result and Option, for small DSLs and config formats.
+
+A Parser reads a value from the start of an Input and returns a Result holding the value and the Input that remains,
+including its line and column. Larger Parsers are built by combining smaller ones, and failures are *Errors that
+report where parsing stopped, which tokens were expected there and what was found instead.
+
+parse consists of:
+
+	Rune/Satisfy/AnyRune/String/Regexp/EOF: Primitive Parsers for runes, strings and regular expressions.
+	Map/Bind/Map2/Map3/Seq/KeepLeft/KeepRight/Between: Sequence Parsers and combine their values.
+	Alt/Optional/Many/Many1/SepBy/SepBy1: Choose between Parsers and repeat them, with Optional yielding an Option.
+	Chain/ChainRight: Parse left- and right-associative operators.
+	Label/Lazy: Name a Parser in errors, and build recursive grammars.
+	Parse: Run a Parser over a whole string.
+
+Usage Example:
+
+	var setting = parse.Map3(
+	    parse.Regexp(`[a-z_]+`).Label("key"),
+	    parse.Regexp(`\s*=\s*`).Label("'='"),
+	    parse.Regexp(`[^\n]*`),
+	    func(key, _, val string) Setting { return Setting{Key: key, Value: val} },
+	)
+
+	var config = parse.SepBy(setting, parse.Rune('\n'))
+
+	settings, err := config.Parse("name = gonads\nlevel: 3").Unpack()
+	// err: line 2, column 6: expected '=', found ':'
+
+In this example, the error points at the line and column where the config stops matching the grammar.
+Alt backtracks, while Optional, Many and SepBy fail if their Parser fails after consuming input, so errors
+inside a partly parsed item are reported rather than silently skipped.
+*/
+package parse
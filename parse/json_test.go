@@ -0,0 +1,182 @@
+package parse_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/alsi-lawr/gonads/parse"
+)
+
+// The tests in this file build a complete JSON parser from the package's combinators,
+// and check it against encoding/json.
+
+var ws = parse.Regexp(`[ \t\r\n]*`)
+
+func token[T any](p parse.Parser[T]) parse.Parser[T] {
+	return parse.KeepLeft(p, ws)
+}
+
+func constant(word string, val any) parse.Parser[any] {
+	return parse.Map(parse.String(word), func(string) any { return val })
+}
+
+var jsonNumber = parse.Map(
+	parse.Regexp(`-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?`).Label("number"),
+	func(s string) any {
+		f, _ := strconv.ParseFloat(s, 64)
+		return f
+	},
+)
+
+var escapes = map[rune]rune{'"': '"', '\\': '\\', '/': '/', 'b': '\b', 'f': '\f', 'n': '\n', 'r': '\r', 't': '\t'}
+
+var jsonChar = parse.Alt(
+	parse.Satisfy("character", func(r rune) bool { return r != '"' && r != '\\' && r >= 0x20 }),
+	parse.KeepRight(parse.Rune('\\'), parse.Alt(
+		parse.Map(parse.Satisfy("escape", func(r rune) bool { _, ok := escapes[r]; return ok }),
+			func(r rune) rune { return escapes[r] }),
+		parse.Map(parse.Regexp(`u[0-9a-fA-F]{4}`), func(s string) rune {
+			n, _ := strconv.ParseUint(s[1:], 16, 16)
+			return rune(n)
+		}),
+	)),
+)
+
+var jsonString = parse.Between(parse.Rune('"'), parse.Many(jsonChar), parse.Rune('"')).Label("string")
+
+// decodeUTF16 joins escaped surrogate pairs, such as "😀", into single runes.
+func decodeUTF16(rs []rune) string {
+	var out []rune
+	for i := 0; i < len(rs); i++ {
+		if i+1 < len(rs) && utf16.IsSurrogate(rs[i]) {
+			if r := utf16.DecodeRune(rs[i], rs[i+1]); r != '�' {
+				out = append(out, r)
+				i++
+				continue
+			}
+		}
+		out = append(out, rs[i])
+	}
+	return string(out)
+}
+
+type member struct {
+	key string
+	val any
+}
+
+var jsonValue, jsonDocument parse.Parser[any]
+
+func init() {
+	str := token(parse.Map(jsonString, decodeUTF16))
+	value := parse.Lazy(func() parse.Parser[any] { return jsonValue })
+
+	array := parse.Map(
+		parse.Between(token(parse.Rune('[')), parse.SepBy(value, token(parse.Rune(','))), token(parse.Rune(']'))),
+		func(vals []any) any { return append([]any{}, vals...) },
+	)
+	object := parse.Map(
+		parse.Between(
+			token(parse.Rune('{')),
+			parse.SepBy(
+				parse.Map3(str, token(parse.Rune(':')), value, func(k string, _ rune, v any) member { return member{k, v} }),
+				token(parse.Rune(',')),
+			),
+			token(parse.Rune('}')),
+		),
+		func(ms []member) any {
+			obj := make(map[string]any, len(ms))
+			for _, m := range ms {
+				obj[m.key] = m.val
+			}
+			return obj
+		},
+	)
+
+	jsonValue = token(parse.Alt(
+		constant("null", nil),
+		constant("true", true),
+		constant("false", false),
+		jsonNumber,
+		parse.Map(str, func(s string) any { return s }),
+		array,
+		object,
+	).Label("value"))
+	jsonDocument = parse.KeepRight(ws, jsonValue)
+}
+
+func TestJSONMatchesEncodingJSON(t *testing.T) {
+	inputs := []string{
+		`null`,
+		`true`,
+		` false `,
+		`0`,
+		`-12.5e3`,
+		`1E-2`,
+		`""`,
+		`"hello, world"`,
+		`"esc\"aped\\ \/ \b\f\n\r\t"`,
+		`"été 😀"`,
+		`"ünïcödé ✓"`,
+		`[]`,
+		`[1, 2, 3]`,
+		`[[], [[]], {}]`,
+		`{}`,
+		`{"a": 1, "b": [true, false, null], "c": {"d": "e"}}`,
+		"{\n\t\"nested\": {\n\t\t\"deeper\": [1, {\"x\": -0.5}]\n\t}\n}\n",
+		`{"dup": 1, "dup": 2}`,
+	}
+	for _, in := range inputs {
+		t.Run(in, func(t *testing.T) {
+			var want any
+			if err := json.Unmarshal([]byte(in), &want); err != nil {
+				t.Fatalf("encoding/json rejected %q: %v", in, err)
+			}
+			got, err := jsonDocument.Parse(in).Unpack()
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", in, err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Parse(%q) = %#v, want %#v", in, got, want)
+			}
+		})
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{``, `line 1, column 1: expected value, found end of input`},
+		{`[1, 2,]`, `line 1, column 7: expected value, found ']'`},
+		{`[1 2]`, `line 1, column 4: expected ',' or ']', found '2'`},
+		{`{"a" 1}`, `line 1, column 6: expected ':', found '1'`},
+		{`{1: 2}`, `line 1, column 2: expected '}' or string, found '1'`},
+		{"{\n  \"a\": tru\n}", `line 2, column 8: expected value, found 't'`},
+		{`"abc`, `line 1, column 5: expected '"', '\\' or character, found end of input`},
+		{`"bad \x"`, `line 1, column 7: expected /u[0-9a-fA-F]{4}/ or escape, found 'x'`},
+		{`[1] x`, `line 1, column 5: expected end of input, found 'x'`},
+		{`01`, `line 1, column 2: expected end of input, found '1'`},
+		{"[\"日本\", ?]", `line 1, column 8: expected value, found '?'`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if json.Valid([]byte(tt.in)) {
+				t.Fatalf("encoding/json accepts %q", tt.in)
+			}
+			_, err := jsonDocument.Parse(tt.in).Unpack()
+			var perr *parse.Error
+			if !errors.As(err, &perr) {
+				t.Fatalf("Parse(%q) error = %v, want a *parse.Error", tt.in, err)
+			}
+			if got := perr.Error(); got != tt.want {
+				t.Errorf("Parse(%q) error =\n    %s\nwant\n    %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,242 @@
+package parse_test
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/parse"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func parseErr[T any](t *testing.T, p parse.Parser[T], in string) *parse.Error {
+	t.Helper()
+	_, err := p.Parse(in).Unpack()
+	var perr *parse.Error
+	if !errors.As(err, &perr) {
+		t.Fatalf("Parse(%q) error = %v, want a *parse.Error", in, err)
+	}
+	return perr
+}
+
+func TestPrimitives(t *testing.T) {
+	if got, _ := parse.Rune('é').Parse("é").Unpack(); got != 'é' {
+		t.Errorf("Rune('é') = %q, want 'é'", got)
+	}
+	if got, _ := parse.String("let").Parse("let").Unpack(); got != "let" {
+		t.Errorf("String(let) = %q, want let", got)
+	}
+	if got, _ := parse.Regexp(`[a-z]+`).Parse("abc").Unpack(); got != "abc" {
+		t.Errorf("Regexp = %q, want abc", got)
+	}
+	if got, _ := parse.AnyRune().Parse("日").Unpack(); got != '日' {
+		t.Errorf("AnyRune = %q, want '日'", got)
+	}
+
+	// Regexp prefers the leftmost alternative over the longest match.
+	if reply, _ := parse.Regexp(`a|ab`)(parse.NewInput("ab")).Unpack(); reply.Value != "a" || reply.Rest != "b" {
+		t.Errorf("Regexp(a|ab) = %q leaving %q, want a leaving b", reply.Value, reply.Rest)
+	}
+	// Regexp matches only at the start of the input.
+	if err := parseErr(t, parse.Regexp(`[0-9]+`), "a1"); err.Pos.Column != 1 {
+		t.Errorf("Regexp matched away from the start: %v", err)
+	}
+	if err := parseErr(t, parse.AnyRune(), ""); err.Found != "end of input" {
+		t.Errorf("AnyRune at end of input found %q", err.Found)
+	}
+}
+
+func TestPositionTracksLinesAndRunes(t *testing.T) {
+	p := parse.KeepRight(parse.Regexp(`(?s)[^!]*`), parse.Rune('!'))
+	err := parseErr(t, p, "ab\nçd?")
+	want := parse.Position{Offset: 7, Line: 2, Column: 4}
+	if err.Pos != want {
+		t.Errorf("Pos = %+v, want %+v", err.Pos, want)
+	}
+
+	r, _ := parse.String("ab\nç")(parse.NewInput("ab\nçd")).Unpack()
+	if r.Rest != "d" || r.Pos != (parse.Position{Offset: 5, Line: 2, Column: 2}) {
+		t.Errorf("Reply = %q at %+v, want \"d\" at line 2, column 2", r.Rest, r.Pos)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	tests := []struct {
+		err  parse.Error
+		want string
+	}{
+		{parse.Error{Pos: parse.Position{Line: 1, Column: 2}, Expected: []string{"x"}, Found: "'y'"},
+			`line 1, column 2: expected x, found 'y'`},
+		{parse.Error{Pos: parse.Position{Line: 3, Column: 1}, Expected: []string{"a", "b", "c"}, Found: "end of input"},
+			`line 3, column 1: expected a, b or c, found end of input`},
+		{parse.Error{Pos: parse.Position{Line: 1, Column: 1}, Found: "'z'"},
+			`line 1, column 1: unexpected 'z'`},
+	}
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("Error() = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestSeqAndMap(t *testing.T) {
+	p := parse.Seq(parse.String("a"), parse.String("b"), parse.String("c"))
+	if got, _ := p.Parse("abc").Unpack(); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Seq = %v, want [a b c]", got)
+	}
+	if err := parseErr(t, p, "abx"); err.Pos.Column != 3 || !reflect.DeepEqual(err.Expected, []string{`"c"`}) {
+		t.Errorf("Seq error = %v", err)
+	}
+
+	pair := parse.Map3(parse.Regexp(`\w+`), parse.Rune('='), parse.Regexp(`\d+`), func(k string, _ rune, v string) string {
+		return v + "=" + k
+	})
+	if got, _ := pair.Parse("x=42").Unpack(); got != "42=x" {
+		t.Errorf("Map3 = %q, want 42=x", got)
+	}
+}
+
+func TestAltMergesExpectations(t *testing.T) {
+	p := parse.Alt(parse.String("ab"), parse.String("ac"), parse.String("d"))
+	if got, _ := p.Parse("ac").Unpack(); got != "ac" {
+		t.Errorf("Alt = %q, want ac", got)
+	}
+	err := parseErr(t, p, "ax")
+	if want := []string{`"ab"`, `"ac"`, `"d"`}; !reflect.DeepEqual(err.Expected, want) {
+		t.Errorf("Expected = %v, want %v", err.Expected, want)
+	}
+
+	// The failure that got further wins.
+	deep := parse.Alt(parse.KeepRight(parse.String("a"), parse.String("b")), parse.String("c"))
+	if err := parseErr(t, deep, "ax"); err.Pos.Column != 2 || !reflect.DeepEqual(err.Expected, []string{`"b"`}) {
+		t.Errorf("Alt error = %v, want the error after 'a'", err)
+	}
+}
+
+func TestLabel(t *testing.T) {
+	ident := parse.Regexp(`[a-z]+`).Label("identifier")
+	if err := parseErr(t, ident, "1"); !reflect.DeepEqual(err.Expected, []string{"identifier"}) {
+		t.Errorf("Expected = %v, want [identifier]", err.Expected)
+	}
+
+	// A failure after consuming input keeps its own expectation.
+	call := parse.KeepLeft(ident, parse.String("()")).Label("call")
+	if err := parseErr(t, call, "f("); !reflect.DeepEqual(err.Expected, []string{`"()"`}) {
+		t.Errorf("Expected = %v, want [\"()\"]", err.Expected)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	sign := parse.Optional(parse.Rune('-'))
+	num := parse.Map2(sign, parse.Regexp(`\d+`), func(s option.Option[rune], d string) string {
+		return string(s.ToSlice()) + d
+	})
+	for in, want := range map[string]string{"-12": "-12", "7": "7"} {
+		if got, _ := num.Parse(in).Unpack(); got != want {
+			t.Errorf("Parse(%q) = %q, want %q", in, got, want)
+		}
+	}
+	if err := parseErr(t, num, "x"); !reflect.DeepEqual(err.Expected, []string{"'-'", `/\d+/`}) {
+		t.Errorf("Expected = %v, want both the sign and the digits", err.Expected)
+	}
+
+	// Optional fails if its Parser fails after consuming input.
+	if err := parseErr(t, parse.Optional(parse.Seq(parse.String("a"), parse.String("b"))), "ac"); err.Pos.Column != 2 {
+		t.Errorf("Optional error = %v, want it after the consumed 'a'", err)
+	}
+}
+
+func TestMany(t *testing.T) {
+	digits := parse.Many(parse.Satisfy("digit", func(r rune) bool { return r >= '0' && r <= '9' }))
+	if got, _ := digits.Parse("").Unpack(); len(got) != 0 {
+		t.Errorf("Many on empty input = %v, want empty", got)
+	}
+	if got, _ := digits.Parse("123").Unpack(); string(got) != "123" {
+		t.Errorf("Many = %q, want 123", string(got))
+	}
+	if err := parseErr(t, parse.Many1(parse.Rune('a')), "b"); err.Pos.Column != 1 {
+		t.Errorf("Many1 error = %v", err)
+	}
+
+	// A Parser that succeeds without consuming input does not loop forever.
+	if got, _ := parse.Many(parse.Pure(1)).Parse("").Unpack(); len(got) != 0 {
+		t.Errorf("Many(Pure) = %v, want empty", got)
+	}
+}
+
+func TestSepBy(t *testing.T) {
+	list := parse.Between(parse.Rune('('), parse.SepBy(parse.Regexp(`\d+`), parse.Rune(',')), parse.Rune(')'))
+	tests := map[string][]string{"()": nil, "(1)": {"1"}, "(1,22,333)": {"1", "22", "333"}}
+	for in, want := range tests {
+		if got, err := list.Parse(in).Unpack(); err != nil || !reflect.DeepEqual(got, want) {
+			t.Errorf("Parse(%q) = %v, %v, want %v", in, got, err, want)
+		}
+	}
+	if err := parseErr(t, list, "(1,)"); err.Pos.Column != 4 || !reflect.DeepEqual(err.Expected, []string{`/\d+/`}) {
+		t.Errorf("trailing separator error = %v", err)
+	}
+	if err := parseErr(t, parse.SepBy1(parse.Rune('x'), parse.Rune(',')), ""); err.Found != "end of input" {
+		t.Errorf("SepBy1 on empty input error = %v", err)
+	}
+}
+
+func TestChain(t *testing.T) {
+	num := parse.Map(parse.Regexp(`\d+`), func(s string) int { n, _ := strconv.Atoi(s); return n })
+	op := func(sym rune, f func(a, b int) int) parse.Parser[func(int, int) int] {
+		return parse.Map(parse.Rune(sym), func(rune) func(int, int) int { return f })
+	}
+
+	// A small arithmetic grammar with precedence, parentheses and a right-associative power.
+	var expr parse.Parser[int]
+	atom := parse.Alt(num, parse.Between(parse.Rune('('), parse.Lazy(func() parse.Parser[int] { return expr }), parse.Rune(')')))
+	power := parse.ChainRight(atom, op('^', func(a, b int) int {
+		out := 1
+		for i := 0; i < b; i++ {
+			out *= a
+		}
+		return out
+	}))
+	term := parse.Chain(power, parse.Alt(op('*', func(a, b int) int { return a * b }), op('/', func(a, b int) int { return a / b })))
+	expr = parse.Chain(term, parse.Alt(op('+', func(a, b int) int { return a + b }), op('-', func(a, b int) int { return a - b })))
+
+	tests := map[string]int{
+		"7":         7,
+		"10-4-3":    3,
+		"2*3+4":     10,
+		"2*(3+4)":   14,
+		"2^3^2":     512,
+		"100/10/5":  2,
+		"(1+2)^2-1": 8,
+	}
+	for in, want := range tests {
+		if got, err := expr.Parse(in).Unpack(); err != nil || got != want {
+			t.Errorf("Parse(%q) = %d, %v, want %d", in, got, err, want)
+		}
+	}
+	if err := parseErr(t, expr, "1+"); err.Pos.Column != 3 {
+		t.Errorf("dangling operator error = %v", err)
+	}
+}
+
+func TestNilErrorIsFailure(t *testing.T) {
+	nilErr := parse.Parser[string](func(parse.Input) result.Result[parse.Reply[string]] {
+		return result.Err[parse.Reply[string]](nil)
+	})
+	// Alt leaves a hint on success, which Bind then merges into the next Parser's result.
+	hinted := parse.Alt(parse.String("x"), parse.Pure(""))
+	tests := map[string]bool{
+		"Seq":      parse.Seq(parse.String("a"), nilErr).Parse("a").IsErr(),
+		"Alt":      parse.Alt(nilErr).Parse("").IsErr(),
+		"Optional": parse.Optional(nilErr).Parse("").IsErr(),
+		"Many":     parse.Many(nilErr).Parse("").IsErr(),
+		"Label":    nilErr.Label("word").Parse("").IsErr(),
+		"Bind":     parse.KeepRight(hinted, nilErr).Parse("").IsErr(),
+	}
+	for name, failed := range tests {
+		if !failed {
+			t.Errorf("%s treated Err(nil) as a success", name)
+		}
+	}
+}
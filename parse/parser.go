@@ -0,0 +1,288 @@
+package parse
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Position is a location in the parsed text. Line and Column count from 1, and Column counts runes.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// String formats the Position as "line L, column C".
+func (p Position) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// advance returns the Position after consuming s.
+func (p Position) advance(s string) Position {
+	p.Offset += len(s)
+	for _, r := range s {
+		if r == '\n' {
+			p.Line++
+			p.Column = 1
+			continue
+		}
+		p.Column++
+	}
+	return p
+}
+
+// Input is the text left to parse, and the Position it starts at.
+type Input struct {
+	Rest string
+	Pos  Position
+}
+
+// NewInput creates an Input at the start of text.
+func NewInput(text string) Input {
+	return Input{Rest: text, Pos: Position{Line: 1, Column: 1}}
+}
+
+// consume returns the Input after its first n bytes.
+func (in Input) consume(n int) Input {
+	return Input{Rest: in.Rest[n:], Pos: in.Pos.advance(in.Rest[:n])}
+}
+
+// Reply is the result of a successful parse: the parsed value and the Input that remains.
+type Reply[T any] struct {
+	Value T
+	Input
+	// hint records what else could have been parsed at the remaining Input, such as another element
+	// after Many stopped, so that a failure there can report it as expected too.
+	hint *Error
+}
+
+// Error is a parse failure, reporting where it happened, what was expected there, and what was found instead.
+type Error struct {
+	Pos      Position
+	Expected []string
+	Found    string
+}
+
+// Error formats the failure as, for example, `line 2, column 5: expected "," or "]", found "x"`.
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Pos.String())
+	b.WriteString(": ")
+	if len(e.Expected) > 0 {
+		b.WriteString("expected ")
+		for i, exp := range e.Expected {
+			switch {
+			case i == 0:
+			case i == len(e.Expected)-1:
+				b.WriteString(" or ")
+			default:
+				b.WriteString(", ")
+			}
+			b.WriteString(exp)
+		}
+		b.WriteString(", found ")
+	} else {
+		b.WriteString("unexpected ")
+	}
+	b.WriteString(e.Found)
+	return b.String()
+}
+
+// expected creates an Error at the start of in, expecting the given tokens.
+func expected(in Input, tokens ...string) *Error {
+	found := "end of input"
+	if r, _ := utf8.DecodeRuneInString(in.Rest); in.Rest != "" {
+		found = strconv.QuoteRune(r)
+	}
+	return &Error{Pos: in.Pos, Expected: tokens, Found: found}
+}
+
+// furthest chooses between two failures, preferring the one that got further into the input and
+// merging the expected tokens of failures at the same position. Errors that are not parse Errors are kept as they are.
+func furthest(a, b error) error {
+	var ea, eb *Error
+	if !errors.As(a, &ea) {
+		return a
+	}
+	if !errors.As(b, &eb) {
+		return b
+	}
+	return merge(ea, eb)
+}
+
+// merge is furthest for parse Errors, either of which may be nil.
+func merge(a, b *Error) *Error {
+	switch {
+	case a == nil || b != nil && b.Pos.Offset > a.Pos.Offset:
+		return b
+	case b == nil || a.Pos.Offset > b.Pos.Offset:
+		return a
+	}
+	exp := append(slices.Clone(a.Expected), b.Expected...)
+	slices.Sort(exp)
+	return &Error{Pos: a.Pos, Expected: slices.Compact(exp), Found: a.Found}
+}
+
+// withHint merges a hint from an earlier Reply into res, if res failed or stopped at the hint's position.
+func withHint[T any](res result.Result[Reply[T]], hint *Error) result.Result[Reply[T]] {
+	if hint == nil {
+		return res
+	}
+	r, err := res.Unpack()
+	if res.IsErr() {
+		var perr *Error
+		if errors.As(err, &perr) && perr.Pos.Offset == hint.Pos.Offset {
+			return result.Err[Reply[T]](merge(hint, perr))
+		}
+		return res
+	}
+	if r.Pos.Offset == hint.Pos.Offset {
+		r.hint = merge(hint, r.hint)
+		return result.Ok(r)
+	}
+	return res
+}
+
+// Parser parses a value from the start of an Input.
+//
+// Type signature:
+//
+//	Parser[T] :: Input -> Result (a, Input)
+//
+// A Parser returns an Ok Reply holding the parsed value and the remaining Input, or an Err, usually an *Error.
+type Parser[T any] func(in Input) result.Result[Reply[T]]
+
+// Parse runs the Parser on text, requiring it to consume all of it.
+//
+// Type signature:
+//
+//	Parse :: Parser a -> String -> Result a
+//
+// If text is not fully consumed, it returns an *Error expecting the end of input.
+func Parse[T any](p Parser[T], text string) result.Result[T] {
+	return result.Map(KeepLeft(p, EOF())(NewInput(text)), func(r Reply[T]) T { return r.Value })
+}
+
+// Parse runs the Parser on text, requiring it to consume all of it.
+//
+// Type signature:
+//
+//	Parse :: Parser a -> String -> Result a
+//
+// If text is not fully consumed, it returns an *Error expecting the end of input.
+func (p Parser[T]) Parse(text string) result.Result[T] {
+	return Parse(p, text)
+}
+
+// Pure creates a Parser that consumes nothing and returns val.
+//
+// Type signature:
+//
+//	Pure :: a -> Parser a
+func Pure[T any](val T) Parser[T] {
+	return func(in Input) result.Result[Reply[T]] {
+		return result.Ok(Reply[T]{Value: val, Input: in})
+	}
+}
+
+// Fail creates a Parser that always fails without consuming anything, expecting the given tokens.
+//
+// Type signature:
+//
+//	Fail :: [String] -> Parser a
+func Fail[T any](tokens ...string) Parser[T] {
+	return func(in Input) result.Result[Reply[T]] {
+		return result.Err[Reply[T]](expected(in, tokens...))
+	}
+}
+
+// Map applies a function to the value parsed by p.
+//
+// Type signature:
+//
+//	Map :: Parser a -> (a -> b) -> Parser b
+func Map[T, U any](p Parser[T], fn func(T) U) Parser[U] {
+	return func(in Input) result.Result[Reply[U]] {
+		return result.Map(p(in), func(r Reply[T]) Reply[U] {
+			return Reply[U]{Value: fn(r.Value), Input: r.Input, hint: r.hint}
+		})
+	}
+}
+
+// Map applies a function to the value parsed by p.
+//
+// Type signature:
+//
+//	Map :: Parser a -> (a -> a) -> Parser a
+func (p Parser[T]) Map(fn func(T) T) Parser[T] {
+	return Map(p, fn)
+}
+
+// Bind parses a value with p, then continues with the Parser fn returns for it.
+//
+// Type signature:
+//
+//	Bind :: Parser a -> (a -> Parser b) -> Parser b
+func Bind[T, U any](p Parser[T], fn func(T) Parser[U]) Parser[U] {
+	return func(in Input) result.Result[Reply[U]] {
+		return result.Bind(p(in), func(r Reply[T]) result.Result[Reply[U]] {
+			return withHint(fn(r.Value)(r.Input), r.hint)
+		})
+	}
+}
+
+// Label replaces what p reports it expected, when it fails without consuming any input.
+//
+// Type signature:
+//
+//	Label :: Parser a -> String -> Parser a
+//
+// This lets a Parser built from several tokens report a single name, such as "number".
+func Label[T any](p Parser[T], name string) Parser[T] {
+	return func(in Input) result.Result[Reply[T]] {
+		res := p(in)
+		r, err := res.Unpack()
+		if res.IsErr() {
+			var perr *Error
+			if errors.As(err, &perr) && perr.Pos.Offset == in.Pos.Offset {
+				return result.Err[Reply[T]](&Error{Pos: perr.Pos, Expected: []string{name}, Found: perr.Found})
+			}
+			return res
+		}
+		if r.hint != nil && r.hint.Pos.Offset == in.Pos.Offset {
+			r.hint = &Error{Pos: r.hint.Pos, Expected: []string{name}, Found: r.hint.Found}
+			return result.Ok(r)
+		}
+		return res
+	}
+}
+
+// Label replaces what p reports it expected, when it fails without consuming any input.
+//
+// Type signature:
+//
+//	Label :: Parser a -> String -> Parser a
+//
+// This lets a Parser built from several tokens report a single name, such as "number".
+func (p Parser[T]) Label(name string) Parser[T] {
+	return Label(p, name)
+}
+
+// Lazy defers building a Parser until it is first run, allowing recursive grammars.
+//
+// Type signature:
+//
+//	Lazy :: (() -> Parser a) -> Parser a
+func Lazy[T any](fn func() Parser[T]) Parser[T] {
+	get := sync.OnceValue(fn)
+	return func(in Input) result.Result[Reply[T]] {
+		return get()(in)
+	}
+}
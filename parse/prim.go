@@ -0,0 +1,96 @@
+package parse
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Satisfy creates a Parser that consumes one rune for which pred returns true.
+//
+// Type signature:
+//
+//	Satisfy :: String -> (Rune -> Bool) -> Parser Rune
+//
+// When it fails, it reports that it expected name.
+func Satisfy(name string, pred func(rune) bool) Parser[rune] {
+	return func(in Input) result.Result[Reply[rune]] {
+		r, size := utf8.DecodeRuneInString(in.Rest)
+		if size == 0 || !pred(r) {
+			return result.Err[Reply[rune]](expected(in, name))
+		}
+		return result.Ok(Reply[rune]{Value: r, Input: in.consume(size)})
+	}
+}
+
+// Rune creates a Parser that consumes the rune r.
+//
+// Type signature:
+//
+//	Rune :: Rune -> Parser Rune
+func Rune(r rune) Parser[rune] {
+	return Satisfy(strconv.QuoteRune(r), func(c rune) bool { return c == r })
+}
+
+// AnyRune creates a Parser that consumes any one rune, failing only at the end of input.
+//
+// Type signature:
+//
+//	AnyRune :: Parser Rune
+func AnyRune() Parser[rune] {
+	return Satisfy("any character", func(rune) bool { return true })
+}
+
+// String creates a Parser that consumes the string s.
+//
+// Type signature:
+//
+//	String :: String -> Parser String
+func String(s string) Parser[string] {
+	name := strconv.Quote(s)
+	return func(in Input) result.Result[Reply[string]] {
+		if !strings.HasPrefix(in.Rest, s) {
+			return result.Err[Reply[string]](expected(in, name))
+		}
+		return result.Ok(Reply[string]{Value: s, Input: in.consume(len(s))})
+	}
+}
+
+// Regexp creates a Parser that consumes the match of pattern at the start of the input.
+//
+// Type signature:
+//
+//	Regexp :: String -> Parser String
+//
+// The match is chosen leftmost-first, as Perl would choose it and regexp does by default, so `a|ab` consumes only
+// "a" of "ab". This is not necessarily the longest match; order alternatives longest first when that matters.
+// When it fails, it reports that it expected /pattern/; use Label to give it a readable name.
+// Regexp panics if pattern does not compile.
+func Regexp(pattern string) Parser[string] {
+	re := regexp.MustCompile(`^(?:` + pattern + `)`)
+	name := "/" + pattern + "/"
+	return func(in Input) result.Result[Reply[string]] {
+		loc := re.FindStringIndex(in.Rest)
+		if loc == nil {
+			return result.Err[Reply[string]](expected(in, name))
+		}
+		return result.Ok(Reply[string]{Value: in.Rest[:loc[1]], Input: in.consume(loc[1])})
+	}
+}
+
+// EOF creates a Parser that succeeds, consuming nothing, only at the end of input.
+//
+// Type signature:
+//
+//	EOF :: Parser ()
+func EOF() Parser[struct{}] {
+	return func(in Input) result.Result[Reply[struct{}]] {
+		if in.Rest != "" {
+			return result.Err[Reply[struct{}]](expected(in, "end of input"))
+		}
+		return result.Ok(Reply[struct{}]{Input: in})
+	}
+}
@@ -0,0 +1,145 @@
+package persistent_test
+
+import (
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/persistent"
+)
+
+// The benchmarks compare each persistent update with the copy-on-write equivalent on a plain slice or map,
+// which must copy the whole collection to leave the original unchanged.
+
+var sizes = []int{100, 10_000, 100_000}
+
+func benchSizes(b *testing.B, fn func(b *testing.B, n int)) {
+	for _, n := range sizes {
+		b.Run(fmt.Sprintf("n=%d", n), func(b *testing.B) { fn(b, n) })
+	}
+}
+
+var sinkVector persistent.Vector[int]
+var sinkSlice []int
+var sinkInt int
+
+func BenchmarkVectorAppend(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		v := persistent.NewVector(seq(n)...)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkVector = v.Append(i)
+		}
+	})
+}
+
+func BenchmarkSliceAppendCopy(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		s := seq(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			next := make([]int, len(s), len(s)+1)
+			copy(next, s)
+			sinkSlice = append(next, i)
+		}
+	})
+}
+
+func BenchmarkVectorSet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		v := persistent.NewVector(seq(n)...)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkVector = v.Set(i%n, i)
+		}
+	})
+}
+
+func BenchmarkSliceSetCopy(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		s := seq(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			next := append([]int(nil), s...)
+			next[i%n] = i
+			sinkSlice = next
+		}
+	})
+}
+
+func BenchmarkVectorGet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		v := persistent.NewVector(seq(n)...)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = v.Get(i % n).GetOrElse(missing)
+		}
+	})
+}
+
+func BenchmarkSliceGet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		s := seq(n)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = s[i%n]
+		}
+	})
+}
+
+var sinkHashMap persistent.HashMap[int, int]
+var sinkMap map[int]int
+
+func BenchmarkHashMapSet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		m := persistent.NewHashMap[int, int]()
+		for i := 0; i < n; i++ {
+			m = m.Set(i, i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkHashMap = m.Set(i%(2*n), i)
+		}
+	})
+}
+
+func BenchmarkMapSetCopy(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		m := make(map[int]int, n)
+		for i := 0; i < n; i++ {
+			m[i] = i
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			next := maps.Clone(m)
+			next[i%(2*n)] = i
+			sinkMap = next
+		}
+	})
+}
+
+func BenchmarkHashMapGet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		m := persistent.NewHashMap[int, int]()
+		for i := 0; i < n; i++ {
+			m = m.Set(i, i)
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = m.Get(i % n).GetOrElse(missing)
+		}
+	})
+}
+
+func BenchmarkMapGet(b *testing.B) {
+	benchSizes(b, func(b *testing.B, n int) {
+		m := make(map[int]int, n)
+		for i := 0; i < n; i++ {
+			m[i] = i
+		}
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			sinkInt = m[i%n]
+		}
+	})
+}
@@ -0,0 +1,27 @@
+/*
+Package persistent provides immutable collections with structural sharing: a Vector and a HashMap.
+
+An iters.Iter is a plain slice, so two pipelines holding the same Iter can see each other's writes to its backing
+array. The collections here cannot be changed in place. Every update returns a new version that shares most of
+its memory with the old one, so keeping old versions around is cheap and safe.
+
+persistent consists of:
+
+	Vector[T]: An indexed sequence, stored as a 32-way trie, with Get, Set, Append and Pop.
+	HashMap[K, V]: A map, stored as a hash array mapped trie, with Get, Has, Set and Delete.
+	Range/ToIter/Keys/Values/Entries/ToMap: Iterate over a collection, or copy it out for the iters operators.
+
+Usage Example:
+
+	base := persistent.NewVector(1, 2, 3)
+	changed := base.Set(0, 10).Append(4)
+
+	fmt.Println(base.ToIter())    // [1 2 3]
+	fmt.Println(changed.ToIter()) // [10 2 3 4]
+
+	evens := changed.ToIter().Filter(func(x int) bool { return x%2 == 0 })
+
+In this example, base is unaffected by the updates that produced changed, and ToIter hands a fresh copy of the
+elements to the iters operators.
+*/
+package persistent
@@ -0,0 +1,363 @@
+package persistent
+
+import (
+	"math/bits"
+
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// HashMap is an immutable map. Every update returns a new HashMap that shares most of its structure
+// with the old one, which is left unchanged.
+//
+// Type signature:
+//
+//	HashMap[K, V] :: Map k v
+//
+// It is a hash array mapped trie, so Get, Set and Delete take O(log32 n) time. Iteration order is unspecified.
+// The zero value is an empty HashMap using the default hash function.
+type HashMap[K comparable, V any] struct {
+	count int
+	root  *hnode[K, V]
+	hash  func(K) uint64
+}
+
+// hnode is a trie node. It either holds entries selected by a bitmap of five bits of the hash,
+// or, once every bit of the hash has been used, a list of entries whose hashes collide.
+type hnode[K comparable, V any] struct {
+	bitmap     uint32
+	entries    []hentry[K, V]
+	collisions bool
+}
+
+// hentry is either a key and value, or, if child is set, a subtrie.
+type hentry[K comparable, V any] struct {
+	hash  uint64
+	key   K
+	val   V
+	child *hnode[K, V]
+}
+
+// Entry is a key and value held by a HashMap.
+type Entry[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+// NewHashMap creates an empty HashMap using the default hash function.
+//
+// Type signature:
+//
+//	NewHashMap :: Map k v
+//
+// The default hash function agrees with == for every comparable key. It handles strings, numbers and booleans
+// directly, and walks other keys, such as structs, with reflection. Use NewHashMapFunc for faster hashing of those.
+func NewHashMap[K comparable, V any]() HashMap[K, V] {
+	return HashMap[K, V]{}
+}
+
+// NewHashMapFunc creates an empty HashMap using the given hash function, which must return equal hashes for equal keys.
+//
+// Type signature:
+//
+//	NewHashMapFunc :: (k -> Uint64) -> Map k v
+func NewHashMapFunc[K comparable, V any](hash func(K) uint64) HashMap[K, V] {
+	return HashMap[K, V]{hash: hash}
+}
+
+// HashMapFromMap creates a HashMap holding the entries of m, using the default hash function.
+//
+// Type signature:
+//
+//	HashMapFromMap :: map[k]v -> Map k v
+func HashMapFromMap[K comparable, V any](m map[K]V) HashMap[K, V] {
+	var hm HashMap[K, V]
+	for k, v := range m {
+		hm = hm.Set(k, v)
+	}
+	return hm
+}
+
+func (m HashMap[K, V]) hashOf(key K) uint64 {
+	if m.hash == nil {
+		return defaultHash(key)
+	}
+	return m.hash(key)
+}
+
+// Len returns the number of entries in the HashMap.
+//
+// Type signature:
+//
+//	Len :: Map k v -> Int
+func (m HashMap[K, V]) Len() int {
+	return m.count
+}
+
+// Get returns the value for key, or None if the HashMap has no entry for it.
+//
+// Type signature:
+//
+//	Get :: Map k v -> k -> Option v
+func (m HashMap[K, V]) Get(key K) option.Option[V] {
+	h := m.hashOf(key)
+	node := m.root
+	for shift := uint(0); node != nil; shift += levelBits {
+		if node.collisions {
+			for _, e := range node.entries {
+				if e.key == key {
+					return option.Some(e.val)
+				}
+			}
+			break
+		}
+		bit := bitFor(h, shift)
+		if node.bitmap&bit == 0 {
+			break
+		}
+		e := node.entries[indexOf(node.bitmap, bit)]
+		if e.child == nil {
+			if e.hash == h && e.key == key {
+				return option.Some(e.val)
+			}
+			break
+		}
+		node = e.child
+	}
+	return option.None[V]()
+}
+
+// Has reports whether the HashMap has an entry for key.
+//
+// Type signature:
+//
+//	Has :: Map k v -> k -> Bool
+func (m HashMap[K, V]) Has(key K) bool {
+	return m.Get(key).IsSome()
+}
+
+// Set returns a HashMap with key mapped to val, adding or replacing its entry.
+//
+// Type signature:
+//
+//	Set :: Map k v -> k -> v -> Map k v
+func (m HashMap[K, V]) Set(key K, val V) HashMap[K, V] {
+	root := m.root
+	if root == nil {
+		root = &hnode[K, V]{}
+	}
+	root, added := insert(root, 0, hentry[K, V]{hash: m.hashOf(key), key: key, val: val})
+	if added {
+		m.count++
+	}
+	m.root = root
+	return m
+}
+
+func bitFor(h uint64, shift uint) uint32 {
+	return 1 << ((h >> shift) & levelMask)
+}
+
+func indexOf(bitmap, bit uint32) int {
+	return bits.OnesCount32(bitmap & (bit - 1))
+}
+
+func insert[K comparable, V any](node *hnode[K, V], shift uint, leaf hentry[K, V]) (*hnode[K, V], bool) {
+	if node.collisions {
+		entries := append([]hentry[K, V](nil), node.entries...)
+		for i, e := range entries {
+			if e.key == leaf.key {
+				entries[i] = leaf
+				return &hnode[K, V]{entries: entries, collisions: true}, false
+			}
+		}
+		return &hnode[K, V]{entries: append(entries, leaf), collisions: true}, true
+	}
+
+	bit := bitFor(leaf.hash, shift)
+	idx := indexOf(node.bitmap, bit)
+	if node.bitmap&bit == 0 {
+		entries := make([]hentry[K, V], 0, len(node.entries)+1)
+		entries = append(append(append(entries, node.entries[:idx]...), leaf), node.entries[idx:]...)
+		return &hnode[K, V]{bitmap: node.bitmap | bit, entries: entries}, true
+	}
+
+	entries := append([]hentry[K, V](nil), node.entries...)
+	e := entries[idx]
+	added := true
+	switch {
+	case e.child != nil:
+		var child *hnode[K, V]
+		child, added = insert(e.child, shift+levelBits, leaf)
+		entries[idx] = hentry[K, V]{child: child}
+	case e.hash == leaf.hash && e.key == leaf.key:
+		entries[idx], added = leaf, false
+	default:
+		entries[idx] = hentry[K, V]{child: split(shift+levelBits, e, leaf)}
+	}
+	return &hnode[K, V]{bitmap: node.bitmap, entries: entries}, added
+}
+
+// split creates the subtrie holding two leaves whose hashes agree up to shift.
+func split[K comparable, V any](shift uint, a, b hentry[K, V]) *hnode[K, V] {
+	if shift >= 64 {
+		return &hnode[K, V]{entries: []hentry[K, V]{a, b}, collisions: true}
+	}
+	bitA, bitB := bitFor(a.hash, shift), bitFor(b.hash, shift)
+	switch {
+	case bitA == bitB:
+		return &hnode[K, V]{bitmap: bitA, entries: []hentry[K, V]{{child: split(shift+levelBits, a, b)}}}
+	case bitA < bitB:
+		return &hnode[K, V]{bitmap: bitA | bitB, entries: []hentry[K, V]{a, b}}
+	}
+	return &hnode[K, V]{bitmap: bitA | bitB, entries: []hentry[K, V]{b, a}}
+}
+
+// Delete returns a HashMap without the entry for key. If there is no such entry, it returns the HashMap unchanged.
+//
+// Type signature:
+//
+//	Delete :: Map k v -> k -> Map k v
+func (m HashMap[K, V]) Delete(key K) HashMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+	root, removed := remove(m.root, 0, m.hashOf(key), key)
+	if !removed {
+		return m
+	}
+	m.count--
+	m.root = root
+	return m
+}
+
+// remove returns the node without key's entry, or nil if the node is left empty.
+func remove[K comparable, V any](node *hnode[K, V], shift uint, h uint64, key K) (*hnode[K, V], bool) {
+	if node.collisions {
+		for i, e := range node.entries {
+			if e.key == key {
+				entries := append(append([]hentry[K, V](nil), node.entries[:i]...), node.entries[i+1:]...)
+				return &hnode[K, V]{entries: entries, collisions: true}, true
+			}
+		}
+		return node, false
+	}
+
+	bit := bitFor(h, shift)
+	if node.bitmap&bit == 0 {
+		return node, false
+	}
+	idx := indexOf(node.bitmap, bit)
+	e := node.entries[idx]
+	if e.child == nil {
+		if e.hash != h || e.key != key {
+			return node, false
+		}
+		if len(node.entries) == 1 {
+			return nil, true
+		}
+		entries := append(append([]hentry[K, V](nil), node.entries[:idx]...), node.entries[idx+1:]...)
+		return &hnode[K, V]{bitmap: node.bitmap &^ bit, entries: entries}, true
+	}
+
+	child, removed := remove(e.child, shift+levelBits, h, key)
+	if !removed {
+		return node, false
+	}
+	entries := append([]hentry[K, V](nil), node.entries...)
+	switch {
+	case child == nil && len(entries) == 1:
+		return nil, true
+	case child == nil:
+		entries = append(entries[:idx], entries[idx+1:]...)
+		return &hnode[K, V]{bitmap: node.bitmap &^ bit, entries: entries}, true
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		// A subtrie left with a single leaf is replaced by the leaf itself.
+		entries[idx] = child.entries[0]
+	default:
+		entries[idx] = hentry[K, V]{child: child}
+	}
+	return &hnode[K, V]{bitmap: node.bitmap, entries: entries}, true
+}
+
+// Range calls fn for each key and value, stopping early if fn returns false.
+//
+// Type signature:
+//
+//	Range :: Map k v -> (k -> v -> Bool) -> ()
+func (m HashMap[K, V]) Range(fn func(key K, val V) bool) {
+	if m.root != nil {
+		m.root.each(fn)
+	}
+}
+
+func (n *hnode[K, V]) each(fn func(K, V) bool) bool {
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.each(fn) {
+				return false
+			}
+			continue
+		}
+		if !fn(e.key, e.val) {
+			return false
+		}
+	}
+	return true
+}
+
+// Keys returns the keys of the HashMap as an Iter, in unspecified order.
+//
+// Type signature:
+//
+//	Keys :: Map k v -> Iter k
+func (m HashMap[K, V]) Keys() iters.Iter[K] {
+	out := make(iters.Iter[K], 0, m.count)
+	m.Range(func(k K, _ V) bool {
+		out = append(out, k)
+		return true
+	})
+	return out
+}
+
+// Values returns the values of the HashMap as an Iter, in unspecified order.
+//
+// Type signature:
+//
+//	Values :: Map k v -> Iter v
+func (m HashMap[K, V]) Values() iters.Iter[V] {
+	out := make(iters.Iter[V], 0, m.count)
+	m.Range(func(_ K, v V) bool {
+		out = append(out, v)
+		return true
+	})
+	return out
+}
+
+// Entries returns the entries of the HashMap as an Iter, in unspecified order.
+//
+// Type signature:
+//
+//	Entries :: Map k v -> Iter (k, v)
+func (m HashMap[K, V]) Entries() iters.Iter[Entry[K, V]] {
+	out := make(iters.Iter[Entry[K, V]], 0, m.count)
+	m.Range(func(k K, v V) bool {
+		out = append(out, Entry[K, V]{Key: k, Value: v})
+		return true
+	})
+	return out
+}
+
+// ToMap copies the entries of the HashMap into a new Go map, for use with the iters map operators such as FilterMap.
+//
+// Type signature:
+//
+//	ToMap :: Map k v -> map[k]v
+func (m HashMap[K, V]) ToMap() map[K]V {
+	out := make(map[K]V, m.count)
+	m.Range(func(k K, v V) bool {
+		out[k] = v
+		return true
+	})
+	return out
+}
@@ -0,0 +1,199 @@
+package persistent_test
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/persistent"
+)
+
+func checkHashMap[K comparable](t *testing.T, m persistent.HashMap[K, int], want map[K]int) {
+	t.Helper()
+	if m.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", m.Len(), len(want))
+	}
+	for k, w := range want {
+		if got := m.Get(k); !got.IsSome() || *got.GetOrNil() != w {
+			t.Fatalf("Get(%v) = %v, want Some(%d)", k, got, w)
+		}
+	}
+	if got := m.ToMap(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("ToMap() = %v, want %v", got, want)
+	}
+}
+
+func TestHashMapZeroValue(t *testing.T) {
+	var m persistent.HashMap[string, int]
+	if m.Len() != 0 || m.Has("a") || len(m.Keys()) != 0 {
+		t.Error("zero HashMap is not empty")
+	}
+	if m.Delete("a").Len() != 0 {
+		t.Error("Delete on an empty HashMap is not empty")
+	}
+	checkHashMap(t, m.Set("a", 1), map[string]int{"a": 1})
+}
+
+func TestHashMapIsPersistent(t *testing.T) {
+	base := persistent.HashMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3})
+	set := base.Set("a", 10).Set("d", 4)
+	deleted := base.Delete("b")
+	unchanged := base.Delete("missing")
+
+	checkHashMap(t, base, map[string]int{"a": 1, "b": 2, "c": 3})
+	checkHashMap(t, set, map[string]int{"a": 10, "b": 2, "c": 3, "d": 4})
+	checkHashMap(t, deleted, map[string]int{"a": 1, "c": 3})
+	checkHashMap(t, unchanged, map[string]int{"a": 1, "b": 2, "c": 3})
+}
+
+// runModel applies random Sets and Deletes to a HashMap and a Go map, checking they agree,
+// and that earlier versions of the HashMap are left unchanged.
+func runModel[K comparable](t *testing.T, m persistent.HashMap[K, int], key func(*rand.Rand) K) {
+	t.Helper()
+	r := rand.New(rand.NewSource(1))
+	want := map[K]int{}
+	var history []persistent.HashMap[K, int]
+	var snapshots []map[K]int
+	for step := 0; step < 20000; step++ {
+		k := key(r)
+		if r.Intn(3) == 0 {
+			m = m.Delete(k)
+			delete(want, k)
+		} else {
+			m = m.Set(k, step)
+			want[k] = step
+		}
+		if step%1000 == 0 {
+			history = append(history, m)
+			snapshots = append(snapshots, m.ToMap())
+		}
+	}
+	checkHashMap(t, m, want)
+	for i, old := range history {
+		checkHashMap(t, old, snapshots[i])
+	}
+	for k := range want {
+		m = m.Delete(k)
+	}
+	checkHashMap(t, m, map[K]int{})
+}
+
+func TestHashMapMatchesMap(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		runModel(t, persistent.NewHashMap[int, int](), func(r *rand.Rand) int { return r.Intn(5000) })
+	})
+	t.Run("string", func(t *testing.T) {
+		runModel(t, persistent.NewHashMap[string, int](), func(r *rand.Rand) string { return fmt.Sprint(r.Intn(5000)) })
+	})
+	t.Run("struct", func(t *testing.T) {
+		type point struct{ X, Y int }
+		runModel(t, persistent.NewHashMap[point, int](), func(r *rand.Rand) point { return point{r.Intn(50), r.Intn(50)} })
+	})
+	t.Run("collisions", func(t *testing.T) {
+		// Every key shares its hash with a hundred others, so full-depth collision nodes are exercised.
+		runModel(t, persistent.NewHashMapFunc[int, int](func(k int) uint64 { return uint64(k % 50) }),
+			func(r *rand.Rand) int { return r.Intn(5000) })
+	})
+	t.Run("shared prefixes", func(t *testing.T) {
+		// Hashes differ only in their top bits, so every key sits deep in the trie.
+		runModel(t, persistent.NewHashMapFunc[int, int](func(k int) uint64 { return uint64(k) << 52 }),
+			func(r *rand.Rand) int { return r.Intn(4096) })
+	})
+}
+
+func TestHashMapFloatKeys(t *testing.T) {
+	negZero := 0.0
+	negZero = -negZero
+	m := persistent.NewHashMap[float64, int]().Set(0, 1)
+	if got := m.Get(negZero); !got.IsSome() {
+		t.Errorf("Get(-0) = %v, want the entry for 0", got)
+	}
+}
+
+func TestHashMapPointerKeys(t *testing.T) {
+	type node struct{ N int }
+	p, q := &node{N: 1}, &node{N: 1}
+	m := persistent.NewHashMap[*node, string]().Set(p, "p").Set(q, "q")
+
+	// Pointers are keys by identity, so changing the pointee does not lose the entry.
+	p.N = 2
+	none := func() string { return "" }
+	if got := m.Get(p).GetOrElse(none); got != "p" {
+		t.Errorf("Get(p) after changing *p = %q, want p", got)
+	}
+	if m.Len() != 2 || m.Get(q).GetOrElse(none) != "q" {
+		t.Errorf("equal pointees were merged into one key: Len() = %d", m.Len())
+	}
+
+	ch := make(chan int)
+	if !persistent.NewHashMap[chan int, int]().Set(ch, 1).Has(ch) {
+		t.Error("Has(ch) = false, want true")
+	}
+}
+
+func TestHashMapStructKeys(t *testing.T) {
+	type inner struct {
+		F float64
+		S string
+	}
+	type key struct {
+		In  inner
+		Arr [2]int
+		Any any
+	}
+	negZero := 0.0
+	negZero = -negZero
+
+	m := persistent.NewHashMap[key, int]().Set(key{In: inner{F: 0, S: "a"}, Arr: [2]int{1, 2}, Any: 3}, 1)
+	if got := m.Get(key{In: inner{F: negZero, S: "a"}, Arr: [2]int{1, 2}, Any: 3}); !got.IsSome() {
+		t.Errorf("Get with F = -0 = %v, want the entry for F = 0", got)
+	}
+	if m.Has(key{In: inner{S: "a"}, Arr: [2]int{1, 2}, Any: int64(3)}) {
+		t.Error("Has matched a key whose interface field holds a different type")
+	}
+	if m.Has(key{In: inner{S: "a"}, Arr: [2]int{2, 1}, Any: 3}) {
+		t.Error("Has matched a key with a different array")
+	}
+}
+
+func TestHashMapIteration(t *testing.T) {
+	m := persistent.HashMapFromMap(map[string]int{"a": 1, "b": 2, "c": 3, "d": 4})
+
+	keys := m.Keys()
+	sort.Strings(keys)
+	if !reflect.DeepEqual(keys, iters.Iter[string]{"a", "b", "c", "d"}) {
+		t.Errorf("Keys() = %v", keys)
+	}
+	values := m.Values()
+	sort.Ints(values)
+	if !reflect.DeepEqual(values, iters.Iter[int]{1, 2, 3, 4}) {
+		t.Errorf("Values() = %v", values)
+	}
+	total := 0
+	for _, e := range m.Entries() {
+		if e.Value != int(e.Key[0]-'a')+1 {
+			t.Errorf("Entry %v has the wrong value", e)
+		}
+		total += e.Value
+	}
+	if total != 10 {
+		t.Errorf("Entries() values sum to %d, want 10", total)
+	}
+
+	visited := 0
+	m.Range(func(string, int) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("Range visited %d entries, want it to stop after 2", visited)
+	}
+
+	odd := iters.FilterMap(m.ToMap(), func(_ string, v int) bool { return v%2 == 1 })
+	if len(odd) != 2 {
+		t.Errorf("FilterMap over ToMap() = %v, want 2 entries", odd)
+	}
+}
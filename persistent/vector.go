@@ -0,0 +1,259 @@
+package persistent
+
+import (
+	"github.com/alsi-lawr/gonads/iters"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+const (
+	levelBits = 5
+	branching = 1 << levelBits
+	levelMask = branching - 1
+)
+
+// Vector is an immutable, indexed sequence. Every update returns a new Vector that shares
+// most of its structure with the old one, which is left unchanged.
+//
+// Type signature:
+//
+//	Vector[T] :: [a]
+//
+// It is a 32-way trie with the last, partly filled block of elements kept aside as a tail, so Get and Set take
+// O(log32 n) time and Append and Pop take amortised constant time. The zero value is an empty Vector.
+type Vector[T any] struct {
+	count int
+	shift uint
+	root  *vnode[T]
+	tail  []T
+}
+
+// vnode is a trie node. Leaves hold up to 32 values, and branches up to 32 children.
+type vnode[T any] struct {
+	children []*vnode[T]
+	values   []T
+}
+
+// NewVector creates a Vector holding the given values, in order.
+//
+// Type signature:
+//
+//	NewVector :: [a] -> Vector a
+//
+// The Vector does not share memory with values, so an Iter can be passed with NewVector(it...).
+func NewVector[T any](values ...T) Vector[T] {
+	var v Vector[T]
+	for _, val := range values {
+		v = v.Append(val)
+	}
+	return v
+}
+
+// Len returns the number of elements in the Vector.
+//
+// Type signature:
+//
+//	Len :: Vector a -> Int
+func (v Vector[T]) Len() int {
+	return v.count
+}
+
+// tailOffset is the index of the first element held in the tail.
+func (v Vector[T]) tailOffset() int {
+	if v.count < branching {
+		return 0
+	}
+	return ((v.count - 1) >> levelBits) << levelBits
+}
+
+// block returns the leaf values, or tail, holding index i.
+func (v Vector[T]) block(i int) []T {
+	if i >= v.tailOffset() {
+		return v.tail
+	}
+	node := v.root
+	for level := v.shift; level > 0; level -= levelBits {
+		node = node.children[(i>>level)&levelMask]
+	}
+	return node.values
+}
+
+// Get returns the element at index i, or None if i is out of range.
+//
+// Type signature:
+//
+//	Get :: Vector a -> Int -> Option a
+func (v Vector[T]) Get(i int) option.Option[T] {
+	if i < 0 || i >= v.count {
+		return option.None[T]()
+	}
+	return option.Some(v.block(i)[i&levelMask])
+}
+
+// Append returns a Vector with the given values added to the end.
+//
+// Type signature:
+//
+//	Append :: Vector a -> [a] -> Vector a
+func (v Vector[T]) Append(values ...T) Vector[T] {
+	for _, val := range values {
+		v = v.push(val)
+	}
+	return v
+}
+
+func (v Vector[T]) push(val T) Vector[T] {
+	if v.count-v.tailOffset() < branching {
+		tail := make([]T, len(v.tail)+1, branching)
+		copy(tail, v.tail)
+		tail[len(v.tail)] = val
+		return Vector[T]{count: v.count + 1, shift: v.shift, root: v.root, tail: tail}
+	}
+
+	// The tail is full: move it into the trie and start a new one.
+	leaf := &vnode[T]{values: v.tail}
+	root, shift := v.root, v.shift
+	switch {
+	case root == nil:
+		root, shift = &vnode[T]{children: []*vnode[T]{leaf}}, levelBits
+	case v.count>>levelBits > 1<<v.shift:
+		root = &vnode[T]{children: []*vnode[T]{root, newPath(v.shift, leaf)}}
+		shift += levelBits
+	default:
+		root = v.pushTail(v.shift, root, leaf)
+	}
+	tail := make([]T, 1, branching)
+	tail[0] = val
+	return Vector[T]{count: v.count + 1, shift: shift, root: root, tail: tail}
+}
+
+func (v Vector[T]) pushTail(level uint, parent, leaf *vnode[T]) *vnode[T] {
+	idx := ((v.count - 1) >> level) & levelMask
+	node := &vnode[T]{children: append([]*vnode[T](nil), parent.children...)}
+	var child *vnode[T]
+	switch {
+	case level == levelBits:
+		child = leaf
+	case idx < len(parent.children):
+		child = v.pushTail(level-levelBits, parent.children[idx], leaf)
+	default:
+		child = newPath(level-levelBits, leaf)
+	}
+	if idx < len(node.children) {
+		node.children[idx] = child
+	} else {
+		node.children = append(node.children, child)
+	}
+	return node
+}
+
+// newPath wraps a leaf in branches up to the given level.
+func newPath[T any](level uint, leaf *vnode[T]) *vnode[T] {
+	if level == 0 {
+		return leaf
+	}
+	return &vnode[T]{children: []*vnode[T]{newPath(level-levelBits, leaf)}}
+}
+
+// Set returns a Vector with the element at index i replaced by val.
+//
+// Type signature:
+//
+//	Set :: Vector a -> Int -> a -> Vector a
+//
+// Set panics if i is out of range, as indexing a slice would.
+func (v Vector[T]) Set(i int, val T) Vector[T] {
+	if i < 0 || i >= v.count {
+		panic("persistent: Vector index out of range")
+	}
+	if i >= v.tailOffset() {
+		tail := make([]T, len(v.tail), branching)
+		copy(tail, v.tail)
+		tail[i&levelMask] = val
+		return Vector[T]{count: v.count, shift: v.shift, root: v.root, tail: tail}
+	}
+	return Vector[T]{count: v.count, shift: v.shift, root: set(v.shift, v.root, i, val), tail: v.tail}
+}
+
+func set[T any](level uint, node *vnode[T], i int, val T) *vnode[T] {
+	if level == 0 {
+		values := append([]T(nil), node.values...)
+		values[i&levelMask] = val
+		return &vnode[T]{values: values}
+	}
+	children := append([]*vnode[T](nil), node.children...)
+	idx := (i >> level) & levelMask
+	children[idx] = set(level-levelBits, children[idx], i, val)
+	return &vnode[T]{children: children}
+}
+
+// Pop returns a Vector without its last element. Popping an empty Vector returns it unchanged.
+//
+// Type signature:
+//
+//	Pop :: Vector a -> Vector a
+func (v Vector[T]) Pop() Vector[T] {
+	switch {
+	case v.count <= 1:
+		return Vector[T]{}
+	case v.count-v.tailOffset() > 1:
+		return Vector[T]{count: v.count - 1, shift: v.shift, root: v.root, tail: v.tail[: len(v.tail)-1 : len(v.tail)-1]}
+	}
+
+	// The tail empties: the last leaf of the trie becomes the new tail.
+	tail := v.block(v.count - 2)
+	root, shift := v.popTail(v.shift, v.root), v.shift
+	switch {
+	case root == nil:
+		shift = 0
+	case shift > levelBits && len(root.children) == 1:
+		root, shift = root.children[0], shift-levelBits
+	}
+	return Vector[T]{count: v.count - 1, shift: shift, root: root, tail: tail[:len(tail):len(tail)]}
+}
+
+func (v Vector[T]) popTail(level uint, node *vnode[T]) *vnode[T] {
+	idx := ((v.count - 2) >> level) & levelMask
+	if level > levelBits {
+		child := v.popTail(level-levelBits, node.children[idx])
+		if child == nil && idx == 0 {
+			return nil
+		}
+		children := append([]*vnode[T](nil), node.children[:idx]...)
+		if child != nil {
+			children = append(children, child)
+		}
+		return &vnode[T]{children: children}
+	}
+	if idx == 0 {
+		return nil
+	}
+	return &vnode[T]{children: node.children[:idx:idx]}
+}
+
+// Range calls fn for each index and element in order, stopping early if fn returns false.
+//
+// Type signature:
+//
+//	Range :: Vector a -> (Int -> a -> Bool) -> ()
+func (v Vector[T]) Range(fn func(i int, val T) bool) {
+	for start := 0; start < v.count; start += branching {
+		for j, val := range v.block(start) {
+			if !fn(start+j, val) {
+				return
+			}
+		}
+	}
+}
+
+// ToIter copies the elements of the Vector into a new Iter, for use with the iters operators.
+//
+// Type signature:
+//
+//	ToIter :: Vector a -> Iter a
+func (v Vector[T]) ToIter() iters.Iter[T] {
+	out := make(iters.Iter[T], 0, v.count)
+	for start := 0; start < v.count; start += branching {
+		out = append(out, v.block(start)...)
+	}
+	return out
+}
@@ -0,0 +1,155 @@
+package persistent_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/persistent"
+)
+
+func seq(n int) []int {
+	out := make([]int, n)
+	for i := range out {
+		out[i] = i
+	}
+	return out
+}
+
+func missing() int {
+	return -1 << 62
+}
+
+func checkVector(t *testing.T, v persistent.Vector[int], want []int) {
+	t.Helper()
+	if v.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", v.Len(), len(want))
+	}
+	for i, w := range want {
+		if got := v.Get(i); !got.IsSome() || *got.GetOrNil() != w {
+			t.Fatalf("Get(%d) = %v, want Some(%d)", i, got, w)
+		}
+	}
+	if got := v.ToIter(); !reflect.DeepEqual([]int(got), append([]int{}, want...)) {
+		t.Fatalf("ToIter() = %v, want %v", got, want)
+	}
+}
+
+func TestVectorZeroValue(t *testing.T) {
+	var v persistent.Vector[string]
+	if v.Len() != 0 || v.Get(0).IsSome() || len(v.ToIter()) != 0 {
+		t.Errorf("zero Vector is not empty: %v", v.ToIter())
+	}
+	if v.Pop().Len() != 0 {
+		t.Error("Pop of an empty Vector is not empty")
+	}
+	if got := v.Append("a").Get(0); !got.Equals(persistent.NewVector("a").Get(0)) {
+		t.Errorf("Append on zero Vector = %v", got)
+	}
+}
+
+func TestVectorGrowsAndShrinks(t *testing.T) {
+	// Sizes around the boundaries where the tail fills and the trie gains a level.
+	const n = 32*32*32 + 100
+	var v persistent.Vector[int]
+	want := []int{}
+	for i := 0; i < n; i++ {
+		v = v.Append(i)
+		want = append(want, i)
+	}
+	checkVector(t, v, want)
+	if v.Get(-1).IsSome() || v.Get(n).IsSome() {
+		t.Error("Get out of range returned Some")
+	}
+
+	for v.Len() > 0 {
+		v = v.Pop()
+		want = want[:len(want)-1]
+		if len(want)%997 == 0 || len(want) < 40 {
+			checkVector(t, v, want)
+		}
+	}
+}
+
+func TestVectorIsPersistent(t *testing.T) {
+	base := persistent.NewVector(seq(100)...)
+	set := base.Set(5, -5).Set(99, -99)
+	appended := base.Append(100, 101)
+	popped := base.Pop()
+
+	checkVector(t, base, seq(100))
+	if base.Get(5).GetOrElse(missing) != 5 || set.Get(5).GetOrElse(missing) != -5 || set.Get(99).GetOrElse(missing) != -99 {
+		t.Errorf("Set changed the original Vector")
+	}
+	if appended.Len() != 102 || popped.Len() != 99 {
+		t.Errorf("Len() = %d and %d, want 102 and 99", appended.Len(), popped.Len())
+	}
+
+	// Two Vectors appended to from the same version must not share their new elements.
+	a, b := popped.Append(1000), popped.Append(2000)
+	if a.Get(99).GetOrElse(missing) != 1000 || b.Get(99).GetOrElse(missing) != 2000 {
+		t.Errorf("diverging Appends share a tail: %v and %v", a.Get(99), b.Get(99))
+	}
+}
+
+func TestVectorMatchesSlice(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	var v persistent.Vector[int]
+	var want []int
+	history := []persistent.Vector[int]{}
+	snapshots := [][]int{}
+	for step := 0; step < 20000; step++ {
+		switch op := r.Intn(10); {
+		case op < 5:
+			v = v.Append(step)
+			want = append(want, step)
+		case op < 8 && len(want) > 0:
+			i := r.Intn(len(want))
+			v = v.Set(i, -step)
+			want = append([]int{}, want...)
+			want[i] = -step
+		default:
+			v = v.Pop()
+			if len(want) > 0 {
+				want = want[: len(want)-1 : len(want)-1]
+			}
+		}
+		if step%500 == 0 {
+			history = append(history, v)
+			snapshots = append(snapshots, append([]int{}, want...))
+		}
+	}
+	checkVector(t, v, want)
+	for i, old := range history {
+		checkVector(t, old, snapshots[i])
+	}
+}
+
+func TestVectorSetPanicsOutOfRange(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Set out of range did not panic")
+		}
+	}()
+	persistent.NewVector(1, 2, 3).Set(3, 4)
+}
+
+func TestVectorRange(t *testing.T) {
+	v := persistent.NewVector(seq(70)...)
+	var seen []int
+	v.Range(func(i, val int) bool {
+		if i != val {
+			t.Errorf("Range gave index %d for value %d", i, val)
+		}
+		seen = append(seen, val)
+		return val < 40
+	})
+	if len(seen) != 41 {
+		t.Errorf("Range visited %d elements, want it to stop after 41", len(seen))
+	}
+
+	evens := v.ToIter().Filter(func(x int) bool { return x%2 == 0 })
+	if len(evens) != 35 {
+		t.Errorf("Filter over ToIter gave %d elements, want 35", len(evens))
+	}
+}
@@ -0,0 +1,33 @@
+/*
+Package reader provides an implementation of the Reader monad, used to thread a shared environment, such as
+configuration or a database handle, through a computation without passing it to every function explicitly.
+
+A Reader is a function from an environment to a value. Readers are composed with Map and Bind, and nothing runs
+until the composed Reader is given its environment with Run.
+
+Reader consists of:
+
+	Reader[E, T]: A computation of a T that depends on an environment E.
+	ReaderResult[E, T]: A Reader whose computation may fail, producing a Result.
+
+Usage Example:
+
+	type Deps struct {
+	    DB  *sql.DB
+	    Log *slog.Logger
+	}
+
+	func findUser(id int) reader.ReaderResult[Deps, User] {
+	    return reader.Lift(func(d Deps) (User, error) { return queryUser(d.DB, id) })
+	}
+
+	func handler(deps Deps) http.HandlerFunc {
+	    return func(w http.ResponseWriter, r *http.Request) {
+	        user, err := findUser(42).Run(deps).Unpack()
+	        // ...
+	    }
+	}
+
+In this example, findUser declares what it needs from its environment, and the handler supplies it once at the edge.
+*/
+package reader
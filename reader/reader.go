@@ -0,0 +1,91 @@
+package reader
+
+// Reader represents a computation that depends on a shared environment.
+//
+// Type signature:
+//
+//	Reader[E, T] :: e -> a
+type Reader[E, T any] func(env E) T
+
+// Of creates a Reader that ignores its environment and returns val.
+//
+// Type signature:
+//
+//	Of :: a -> Reader e a
+func Of[E, T any](val T) Reader[E, T] {
+	return func(E) T { return val }
+}
+
+// Ask creates a Reader that returns its environment.
+//
+// Type signature:
+//
+//	Ask :: Reader e e
+func Ask[E any]() Reader[E, E] {
+	return func(env E) E { return env }
+}
+
+// Asks creates a Reader that returns a value derived from its environment.
+//
+// Type signature:
+//
+//	Asks :: (e -> a) -> Reader e a
+func Asks[E, T any](fn func(E) T) Reader[E, T] {
+	return fn
+}
+
+// Run runs the Reader with an environment.
+//
+// Type signature:
+//
+//	Run :: Reader e a -> e -> a
+func (r Reader[E, T]) Run(env E) T {
+	return r(env)
+}
+
+// Map applies a function to the value of the Reader.
+//
+// Type signature:
+//
+//	Map :: Reader e a -> (a -> a) -> Reader e a
+func (r Reader[E, T]) Map(fn func(T) T) Reader[E, T] {
+	return Map(r, fn)
+}
+
+// Map applies a function to the value of the Reader.
+//
+// Type signature:
+//
+//	Map :: Reader e a -> (a -> b) -> Reader e b
+func Map[E, T, U any](r Reader[E, T], fn func(T) U) Reader[E, U] {
+	return func(env E) U { return fn(r(env)) }
+}
+
+// Bind applies a function returning a Reader to the value of the Reader, running both with the same environment.
+//
+// Type signature:
+//
+//	Bind :: Reader e a -> (a -> Reader e a) -> Reader e a
+func (r Reader[E, T]) Bind(fn func(T) Reader[E, T]) Reader[E, T] {
+	return Bind(r, fn)
+}
+
+// Bind applies a function returning a Reader to the value of the Reader, running both with the same environment.
+//
+// Type signature:
+//
+//	Bind :: Reader e a -> (a -> Reader e b) -> Reader e b
+func Bind[E, T, U any](r Reader[E, T], fn func(T) Reader[E, U]) Reader[E, U] {
+	return func(env E) U { return fn(r(env))(env) }
+}
+
+// Local runs the Reader with an environment modified by fn.
+//
+// Type signature:
+//
+//	Local :: Reader e a -> (e -> e) -> Reader e a
+//
+// It is useful for overriding part of the environment, such as a logger or timeout, for a sub-computation.
+func Local[E, T any](r Reader[E, T], fn func(E) E) Reader[E, T] {
+	return func(env E) T { return r(fn(env)) }
+}
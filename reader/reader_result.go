@@ -0,0 +1,106 @@
+package reader
+
+import "github.com/alsi-lawr/gonads/result"
+
+// ReaderResult represents a fallible computation that depends on a shared environment.
+//
+// Type signature:
+//
+//	ReaderResult[E, T] :: e -> Result a
+type ReaderResult[E, T any] func(env E) result.Result[T]
+
+// Ok creates a ReaderResult that ignores its environment and succeeds with val.
+//
+// Type signature:
+//
+//	Ok :: a -> ReaderResult e a
+func Ok[E, T any](val T) ReaderResult[E, T] {
+	return FromResult[E](result.Ok(val))
+}
+
+// Err creates a ReaderResult that ignores its environment and fails with err.
+//
+// Type signature:
+//
+//	Err :: error -> ReaderResult e a
+func Err[E, T any](err error) ReaderResult[E, T] {
+	return FromResult[E](result.Err[T](err))
+}
+
+// FromResult creates a ReaderResult that ignores its environment and returns r.
+//
+// Type signature:
+//
+//	FromResult :: Result a -> ReaderResult e a
+func FromResult[E, T any](r result.Result[T]) ReaderResult[E, T] {
+	return func(E) result.Result[T] { return r }
+}
+
+// FromReader creates a ReaderResult that always succeeds with the value of r.
+//
+// Type signature:
+//
+//	FromReader :: Reader e a -> ReaderResult e a
+func FromReader[E, T any](r Reader[E, T]) ReaderResult[E, T] {
+	return func(env E) result.Result[T] { return result.Ok(r(env)) }
+}
+
+// Lift lifts a function of the environment that returns a value and an error into a ReaderResult.
+//
+// Type signature:
+//
+//	Lift :: (e -> (a, error)) -> ReaderResult e a
+func Lift[E, T any](fn func(E) (T, error)) ReaderResult[E, T] {
+	return func(env E) result.Result[T] {
+		return result.Lift(func() (T, error) { return fn(env) })
+	}
+}
+
+// Run runs the ReaderResult with an environment.
+//
+// Type signature:
+//
+//	Run :: ReaderResult e a -> e -> Result a
+func (rr ReaderResult[E, T]) Run(env E) result.Result[T] {
+	return rr(env)
+}
+
+// Map applies a function to the value of the ReaderResult, if it succeeds.
+//
+// Type signature:
+//
+//	Map :: ReaderResult e a -> (a -> a) -> ReaderResult e a
+func (rr ReaderResult[E, T]) Map(fn func(T) T) ReaderResult[E, T] {
+	return MapResult(rr, fn)
+}
+
+// MapResult applies a function to the value of the ReaderResult, if it succeeds.
+//
+// Type signature:
+//
+//	MapResult :: ReaderResult e a -> (a -> b) -> ReaderResult e b
+func MapResult[E, T, U any](rr ReaderResult[E, T], fn func(T) U) ReaderResult[E, U] {
+	return func(env E) result.Result[U] { return result.Map(rr(env), fn) }
+}
+
+// Bind applies a function returning a ReaderResult to the value of the ReaderResult, if it succeeds.
+//
+// Type signature:
+//
+//	Bind :: ReaderResult e a -> (a -> ReaderResult e a) -> ReaderResult e a
+func (rr ReaderResult[E, T]) Bind(fn func(T) ReaderResult[E, T]) ReaderResult[E, T] {
+	return BindResult(rr, fn)
+}
+
+// BindResult applies a function returning a ReaderResult to the value of the ReaderResult, if it succeeds.
+//
+// Type signature:
+//
+//	BindResult :: ReaderResult e a -> (a -> ReaderResult e b) -> ReaderResult e b
+//
+// Both computations run with the same environment. If the first fails, fn is not called and its error is propagated.
+func BindResult[E, T, U any](rr ReaderResult[E, T], fn func(T) ReaderResult[E, U]) ReaderResult[E, U] {
+	return func(env E) result.Result[U] {
+		return result.Bind(rr(env), func(val T) result.Result[U] { return fn(val)(env) })
+	}
+}
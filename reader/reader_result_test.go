@@ -0,0 +1,74 @@
+package reader_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/reader"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+type store map[int]string
+
+func find(id int) reader.ReaderResult[store, string] {
+	return reader.Lift(func(s store) (string, error) {
+		name, ok := s[id]
+		if !ok {
+			return "", errors.New("not found")
+		}
+		return name, nil
+	})
+}
+
+func TestReaderResultLift(t *testing.T) {
+	s := store{1: "ada"}
+	if got := find(1).Run(s); !result.Equal(got, result.Ok("ada")) {
+		t.Errorf("expected Ok(ada), got %v", got)
+	}
+	if got := find(2).Run(s); got.IsOk() {
+		t.Errorf("expected Err, got %v", got)
+	}
+}
+
+func TestReaderResultConstructors(t *testing.T) {
+	errTest := errors.New("test error")
+	if got := reader.Ok[store](1).Run(nil); !result.Equal(got, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+	if _, err := reader.Err[store, int](errTest).Run(nil).Unpack(); err != errTest {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+	size := reader.FromReader(reader.Asks(func(s store) int { return len(s) }))
+	if got := size.Run(store{1: "a"}); !result.Equal(got, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+}
+
+func TestReaderResultMapAndBind(t *testing.T) {
+	s := store{1: "ada", 2: "bob"}
+	length := reader.MapResult(find(1), func(name string) int { return len(name) })
+	if got := length.Run(s); !result.Equal(got, result.Ok(3)) {
+		t.Errorf("expected Ok(3), got %v", got)
+	}
+
+	upper := find(2).Map(func(name string) string { return name + "!" })
+	if got := upper.Run(s); !result.Equal(got, result.Ok("bob!")) {
+		t.Errorf("expected Ok(bob!), got %v", got)
+	}
+
+	called := false
+	chained := reader.BindResult(find(3), func(name string) reader.ReaderResult[store, int] {
+		called = true
+		return reader.Ok[store](len(name))
+	})
+	if got := chained.Run(s); got.IsOk() || called {
+		t.Errorf("expected the error to short-circuit, got %v", got)
+	}
+
+	both := find(1).Bind(func(a string) reader.ReaderResult[store, string] {
+		return reader.MapResult(find(2), func(b string) string { return a + "&" + b })
+	})
+	if got := both.Run(s); !result.Equal(got, result.Ok("ada&bob")) {
+		t.Errorf("expected Ok(ada&bob), got %v", got)
+	}
+}
@@ -0,0 +1,60 @@
+package reader_test
+
+import (
+	"testing"
+
+	"github.com/alsi-lawr/gonads/reader"
+)
+
+type config struct {
+	Name  string
+	Limit int
+}
+
+func TestReaderBasics(t *testing.T) {
+	env := config{Name: "svc", Limit: 10}
+	if got := reader.Of[config](3).Run(env); got != 3 {
+		t.Errorf("expected 3, got %d", got)
+	}
+	if got := reader.Ask[config]().Run(env); got != env {
+		t.Errorf("expected the environment, got %v", got)
+	}
+	if got := reader.Asks(func(c config) string { return c.Name }).Run(env); got != "svc" {
+		t.Errorf("expected \"svc\", got %q", got)
+	}
+}
+
+func TestReaderMapAndBind(t *testing.T) {
+	limit := reader.Asks(func(c config) int { return c.Limit })
+	doubled := limit.Map(func(x int) int { return x * 2 })
+	label := reader.Bind(doubled, func(n int) reader.Reader[config, string] {
+		return reader.Asks(func(c config) string { return c.Name + ":" + string(rune('0'+n/10)) })
+	})
+	if got := label.Run(config{Name: "svc", Limit: 10}); got != "svc:2" {
+		t.Errorf("expected \"svc:2\", got %q", got)
+	}
+
+	same := limit.Bind(func(n int) reader.Reader[config, int] { return reader.Of[config](n + 1) })
+	if got := same.Run(config{Limit: 1}); got != 2 {
+		t.Errorf("expected 2, got %d", got)
+	}
+	mapped := reader.Map(limit, func(n int) bool { return n > 5 })
+	if !mapped.Run(config{Limit: 6}) {
+		t.Errorf("expected true")
+	}
+}
+
+func TestReaderLocal(t *testing.T) {
+	limit := reader.Asks(func(c config) int { return c.Limit })
+	raised := reader.Local(limit, func(c config) config {
+		c.Limit = 100
+		return c
+	})
+	env := config{Limit: 1}
+	if got := raised.Run(env); got != 100 {
+		t.Errorf("expected 100, got %d", got)
+	}
+	if got := limit.Run(env); got != 1 {
+		t.Errorf("expected the original environment to be unchanged, got %d", got)
+	}
+}
@@ -0,0 +1,180 @@
+package result
+
+import "errors"
+
+// Apply applies a function wrapped in a Result to a value wrapped in a Result.
+//
+// Type signature:
+//
+//	Apply :: Result (a -> b) -> Result a -> Result b
+//
+// If both Results are Ok, it returns Ok with the result of the function.
+// Otherwise, it fails fast, returning the first error in argument order.
+func Apply[T, U any](fn Result[func(T) U], r Result[T]) Result[U] {
+	switch {
+	case fn.isErr:
+		return Err[U](fn.err)
+	case r.isErr:
+		return Err[U](r.err)
+	}
+	return Ok(fn.value(r.value))
+}
+
+// ApplyJoin applies a function wrapped in a Result to a value wrapped in a Result, accumulating errors.
+//
+// Type signature:
+//
+//	ApplyJoin :: Result (a -> b) -> Result a -> Result b
+//
+// If both Results are Ok, it returns Ok with the result of the function.
+// Otherwise, it returns an Err holding the errors of every failed Result, combined with errors.Join.
+func ApplyJoin[T, U any](fn Result[func(T) U], r Result[T]) Result[U] {
+	if fn.isErr || r.isErr {
+		return Err[U](errors.Join(fn.err, r.err))
+	}
+	return Ok(fn.value(r.value))
+}
+
+// Map2 combines 2 Results with a function of 2 arguments.
+//
+// Type signature:
+//
+//	Map2 :: Result a -> Result b -> (a -> b -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it fails fast, returning the first error in argument order. Use Map2Join to collect every error.
+func Map2[T1, T2, U any](r1 Result[T1], r2 Result[T2], fn func(T1, T2) U) Result[U] {
+	switch {
+	case r1.isErr:
+		return Err[U](r1.err)
+	case r2.isErr:
+		return Err[U](r2.err)
+	}
+	return Ok(fn(r1.value, r2.value))
+}
+
+// Map2Join combines 2 Results with a function of 2 arguments, accumulating errors.
+//
+// Type signature:
+//
+//	Map2Join :: Result a -> Result b -> (a -> b -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it returns an Err holding the errors of every failed Result, combined with errors.Join.
+func Map2Join[T1, T2, U any](r1 Result[T1], r2 Result[T2], fn func(T1, T2) U) Result[U] {
+	if r1.isErr || r2.isErr {
+		return Err[U](errors.Join(r1.err, r2.err))
+	}
+	return Ok(fn(r1.value, r2.value))
+}
+
+// Map3 combines 3 Results with a function of 3 arguments.
+//
+// Type signature:
+//
+//	Map3 :: Result a -> Result b -> Result c -> (a -> b -> c -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it fails fast, returning the first error in argument order. Use Map3Join to collect every error.
+func Map3[T1, T2, T3, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], fn func(T1, T2, T3) U) Result[U] {
+	switch {
+	case r1.isErr:
+		return Err[U](r1.err)
+	case r2.isErr:
+		return Err[U](r2.err)
+	case r3.isErr:
+		return Err[U](r3.err)
+	}
+	return Ok(fn(r1.value, r2.value, r3.value))
+}
+
+// Map3Join combines 3 Results with a function of 3 arguments, accumulating errors.
+//
+// Type signature:
+//
+//	Map3Join :: Result a -> Result b -> Result c -> (a -> b -> c -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it returns an Err holding the errors of every failed Result, combined with errors.Join.
+func Map3Join[T1, T2, T3, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], fn func(T1, T2, T3) U) Result[U] {
+	if r1.isErr || r2.isErr || r3.isErr {
+		return Err[U](errors.Join(r1.err, r2.err, r3.err))
+	}
+	return Ok(fn(r1.value, r2.value, r3.value))
+}
+
+// Map4 combines 4 Results with a function of 4 arguments.
+//
+// Type signature:
+//
+//	Map4 :: Result a -> Result b -> Result c -> Result d -> (a -> b -> c -> d -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it fails fast, returning the first error in argument order. Use Map4Join to collect every error.
+func Map4[T1, T2, T3, T4, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], r4 Result[T4], fn func(T1, T2, T3, T4) U) Result[U] {
+	switch {
+	case r1.isErr:
+		return Err[U](r1.err)
+	case r2.isErr:
+		return Err[U](r2.err)
+	case r3.isErr:
+		return Err[U](r3.err)
+	case r4.isErr:
+		return Err[U](r4.err)
+	}
+	return Ok(fn(r1.value, r2.value, r3.value, r4.value))
+}
+
+// Map4Join combines 4 Results with a function of 4 arguments, accumulating errors.
+//
+// Type signature:
+//
+//	Map4Join :: Result a -> Result b -> Result c -> Result d -> (a -> b -> c -> d -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it returns an Err holding the errors of every failed Result, combined with errors.Join.
+func Map4Join[T1, T2, T3, T4, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], r4 Result[T4], fn func(T1, T2, T3, T4) U) Result[U] {
+	if r1.isErr || r2.isErr || r3.isErr || r4.isErr {
+		return Err[U](errors.Join(r1.err, r2.err, r3.err, r4.err))
+	}
+	return Ok(fn(r1.value, r2.value, r3.value, r4.value))
+}
+
+// Map5 combines 5 Results with a function of 5 arguments.
+//
+// Type signature:
+//
+//	Map5 :: Result a -> Result b -> Result c -> Result d -> Result e -> (a -> b -> c -> d -> e -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it fails fast, returning the first error in argument order. Use Map5Join to collect every error.
+func Map5[T1, T2, T3, T4, T5, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], r4 Result[T4], r5 Result[T5], fn func(T1, T2, T3, T4, T5) U) Result[U] {
+	switch {
+	case r1.isErr:
+		return Err[U](r1.err)
+	case r2.isErr:
+		return Err[U](r2.err)
+	case r3.isErr:
+		return Err[U](r3.err)
+	case r4.isErr:
+		return Err[U](r4.err)
+	case r5.isErr:
+		return Err[U](r5.err)
+	}
+	return Ok(fn(r1.value, r2.value, r3.value, r4.value, r5.value))
+}
+
+// Map5Join combines 5 Results with a function of 5 arguments, accumulating errors.
+//
+// Type signature:
+//
+//	Map5Join :: Result a -> Result b -> Result c -> Result d -> Result e -> (a -> b -> c -> d -> e -> r) -> Result r
+//
+// If every Result is Ok, it returns Ok with the result of fn.
+// Otherwise, it returns an Err holding the errors of every failed Result, combined with errors.Join.
+func Map5Join[T1, T2, T3, T4, T5, U any](r1 Result[T1], r2 Result[T2], r3 Result[T3], r4 Result[T4], r5 Result[T5], fn func(T1, T2, T3, T4, T5) U) Result[U] {
+	if r1.isErr || r2.isErr || r3.isErr || r4.isErr || r5.isErr {
+		return Err[U](errors.Join(r1.err, r2.err, r3.err, r4.err, r5.err))
+	}
+	return Ok(fn(r1.value, r2.value, r3.value, r4.value, r5.value))
+}
@@ -0,0 +1,77 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestApply(t *testing.T) {
+	errFn := errors.New("fn")
+	errVal := errors.New("value")
+	double := result.Ok(func(x int) int { return x * 2 })
+
+	if got := result.Apply(double, result.Ok(21)); !result.Equal(got, result.Ok(42)) {
+		t.Errorf("expected Ok(42), got %v", got)
+	}
+	if _, err := result.Apply(result.Err[func(int) int](errFn), result.Err[int](errVal)).Unpack(); err != errFn {
+		t.Errorf("expected the first error, got %v", err)
+	}
+	_, err := result.ApplyJoin(result.Err[func(int) int](errFn), result.Err[int](errVal)).Unpack()
+	if !errors.Is(err, errFn) || !errors.Is(err, errVal) {
+		t.Errorf("expected both errors, got %v", err)
+	}
+	if got := result.ApplyJoin(double, result.Ok(21)); !result.Equal(got, result.Ok(42)) {
+		t.Errorf("expected Ok(42), got %v", got)
+	}
+}
+
+func TestMapN(t *testing.T) {
+	one := result.Ok(1)
+	got := []result.Result[int]{
+		result.Map2(one, one, func(a, b int) int { return a + b }),
+		result.Map3(one, one, one, func(a, b, c int) int { return a + b + c }),
+		result.Map4(one, one, one, one, func(a, b, c, d int) int { return a + b + c + d }),
+		result.Map5(one, one, one, one, one, func(a, b, c, d, e int) int { return a + b + c + d + e }),
+		result.Map2Join(one, one, func(a, b int) int { return a + b }),
+		result.Map3Join(one, one, one, func(a, b, c int) int { return a + b + c }),
+		result.Map4Join(one, one, one, one, func(a, b, c, d int) int { return a + b + c + d }),
+		result.Map5Join(one, one, one, one, one, func(a, b, c, d, e int) int { return a + b + c + d + e }),
+	}
+	for i, r := range got {
+		if want := result.Ok(i%4 + 2); !result.Equal(r, want) {
+			t.Errorf("case %d: expected %v, got %v", i, want, r)
+		}
+	}
+}
+
+func TestMapNFailFast(t *testing.T) {
+	errB := errors.New("b")
+	errC := errors.New("c")
+	called := false
+	r := result.Map3(result.Ok(1), result.Err[string](errB), result.Err[bool](errC), func(int, string, bool) int {
+		called = true
+		return 0
+	})
+	if _, err := r.Unpack(); err != errB {
+		t.Errorf("expected the first error, got %v", err)
+	}
+	if called {
+		t.Errorf("expected fn not to be called")
+	}
+}
+
+func TestMapNJoin(t *testing.T) {
+	errA := errors.New("a")
+	errD := errors.New("d")
+	r := result.Map5Join(result.Err[int](errA), result.Ok(2), result.Ok(3), result.Err[int](errD), result.Ok(5),
+		func(a, b, c, d, e int) int { return a + b + c + d + e })
+	_, err := r.Unpack()
+	if !errors.Is(err, errA) || !errors.Is(err, errD) {
+		t.Errorf("expected both errors, got %v", err)
+	}
+	if err.Error() != "a\nd" {
+		t.Errorf("expected errors in argument order, got %q", err.Error())
+	}
+}
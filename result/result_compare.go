@@ -0,0 +1,64 @@
+package result
+
+// Equal reports whether two Result values of a comparable type are equal, without reflection.
+//
+// Type signature:
+//
+//	Equal :: Result a -> Result a -> Bool
+//
+// Two Results are equal if both are Ok with values that are equal under ==,
+// or both are Err with errors that are equal under ==. Errors whose dynamic type is not comparable are never equal.
+func Equal[T comparable](a, b Result[T]) bool {
+	return EqualFunc(a, b, func(x, y T) bool { return x == y })
+}
+
+// EqualFunc reports whether two Result values are equal, using eq to compare their values.
+//
+// Type signature:
+//
+//	EqualFunc :: Result a -> Result a -> ((a, a) -> Bool) -> Bool
+//
+// Two Results are equal if both are Ok and eq returns true for their values,
+// or both are Err with errors that are equal under ==. Errors whose dynamic type is not comparable are never equal,
+// rather than panicking as == would. eq is only called when both Results are Ok.
+func EqualFunc[T any](a, b Result[T], eq func(T, T) bool) bool {
+	if a.isErr != b.isErr {
+		return false
+	}
+	if a.isErr {
+		return sameError(a.err, b.err)
+	}
+	return eq(a.value, b.value)
+}
+
+// CompareFunc compares two Result values, using compare to order their values and compareErr to order their errors.
+//
+// Type signature:
+//
+//	CompareFunc :: Result a -> Result a -> ((a, a) -> Int) -> ((error, error) -> Int) -> Int
+//
+// Err sorts before any Ok, two Err values are ordered by compareErr and two Ok values by compare.
+// There is no Compare for ordered types, as errors have no natural order.
+func CompareFunc[T any](a, b Result[T], compare func(T, T) int, compareErr func(error, error) int) int {
+	switch {
+	case a.isErr && b.isErr:
+		return compareErr(a.err, b.err)
+	case a.isErr:
+		return -1
+	case b.isErr:
+		return 1
+	default:
+		return compare(a.value, b.value)
+	}
+}
+
+// sameError compares two errors with ==. Comparing two errors of the same non-comparable dynamic type
+// panics, and such errors are reported as unequal instead.
+func sameError(a, b error) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a == b
+}
@@ -0,0 +1,77 @@
+package result_test
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestEqual(t *testing.T) {
+	testErr := errors.New("test error")
+	if !result.Equal(result.Ok(1), result.Ok(1)) {
+		t.Errorf("expected equal Ok values to be equal")
+	}
+	if result.Equal(result.Ok(1), result.Ok(2)) {
+		t.Errorf("expected different Ok values to be unequal")
+	}
+	if !result.Equal(result.Err[int](testErr), result.Err[int](testErr)) {
+		t.Errorf("expected identical errors to be equal")
+	}
+	if result.Equal(result.Err[int](testErr), result.Err[int](errors.New("test error"))) {
+		t.Errorf("expected distinct errors to be unequal")
+	}
+	if result.Equal(result.Ok(0), result.Err[int](testErr)) {
+		t.Errorf("expected Ok and Err to be unequal")
+	}
+}
+
+type fieldsError struct{ fields []string }
+
+func (e fieldsError) Error() string { return "invalid fields" }
+
+func TestEqualNonComparableErrors(t *testing.T) {
+	a := result.Err[int](fieldsError{fields: []string{"name"}})
+	b := result.Err[int](fieldsError{fields: []string{"name"}})
+	if result.Equal(a, b) {
+		t.Errorf("expected errors of a non-comparable type to be unequal")
+	}
+	if result.Equal(a, result.Err[int](errors.New("invalid fields"))) {
+		t.Errorf("expected errors of different types to be unequal")
+	}
+	if !result.Equal(result.Err[int](nil), result.Err[int](nil)) {
+		t.Errorf("expected nil errors to be equal")
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	eq := func(a, b []int) bool { return slices.Equal(a, b) }
+	if !result.EqualFunc(result.Ok([]int{1}), result.Ok([]int{1}), eq) {
+		t.Errorf("expected equal Ok values to be equal")
+	}
+	if result.EqualFunc(result.Ok([]int{1}), result.Ok([]int{2}), eq) {
+		t.Errorf("expected different Ok values to be unequal")
+	}
+}
+
+func TestCompareFunc(t *testing.T) {
+	byMessage := func(a, b error) int { return cmp.Compare(a.Error(), b.Error()) }
+	res := []result.Result[int]{
+		result.Ok(3), result.Err[int](errors.New("b")), result.Ok(1), result.Err[int](errors.New("a")),
+	}
+	slices.SortFunc(res, func(a, b result.Result[int]) int {
+		return result.CompareFunc(a, b, cmp.Compare[int], byMessage)
+	})
+	var got []string
+	for _, r := range res {
+		got = append(got, r.String())
+	}
+	if want := []string{"Err(a)", "Err(b)", "Ok(1)", "Ok(3)"}; !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if result.CompareFunc(result.Ok(0), result.Err[int](nil), cmp.Compare[int], byMessage) != 1 {
+		t.Errorf("expected Ok to sort after Err")
+	}
+}
@@ -0,0 +1,183 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+	"sync/atomic"
+)
+
+var captureStacks atomic.Bool
+
+// SetStackCapture enables or disables stack capture for errors annotated with Context or Wrapf.
+// Capture is disabled by default, as walking the stack on every annotation has a measurable cost.
+//
+// It is safe to call concurrently, and affects only annotations made after the call.
+func SetStackCapture(enabled bool) {
+	captureStacks.Store(enabled)
+}
+
+// Frame describes a single annotation in the context chain of an Err.
+// File, Function and Line are only populated when stack capture is enabled.
+type Frame struct {
+	Message  string
+	Function string
+	File     string
+	Line     int
+}
+
+// String formats the Frame as its message, followed by its location when one was captured.
+func (f Frame) String() string {
+	if f.File == "" {
+		return f.Message
+	}
+	return fmt.Sprintf("%s (%s:%d %s)", f.Message, f.File, f.Line, f.Function)
+}
+
+// ContextError is an error annotated with a message by Context or Wrapf.
+// It unwraps to the annotated error, so errors.Is and errors.As see through any number of annotations.
+//
+// Formatting a ContextError with %+v prints every annotation on its own line, along with the
+// location it was made at when stack capture is enabled, followed by the root cause.
+type ContextError struct {
+	msg   string
+	err   error
+	stack []uintptr
+}
+
+func wrapContext(err error, msg string) *ContextError {
+	ce := &ContextError{msg: msg, err: err}
+	if captureStacks.Load() {
+		pcs := make([]uintptr, 32)
+		// skip runtime.Callers, wrapContext and the Result method that called it
+		n := runtime.Callers(3, pcs)
+		ce.stack = pcs[:n]
+	}
+	return ce
+}
+
+// Error returns the annotation message followed by the message of the annotated error,
+// or just the annotation message if the annotated error is nil.
+func (e *ContextError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+// Unwrap returns the annotated error.
+func (e *ContextError) Unwrap() error {
+	return e.err
+}
+
+// Frame returns the annotation described by this error.
+func (e *ContextError) Frame() Frame {
+	f := Frame{Message: e.msg}
+	if len(e.stack) == 0 {
+		return f
+	}
+	rf, _ := runtime.CallersFrames(e.stack).Next()
+	f.Function, f.File, f.Line = rf.Function, rf.File, rf.Line
+	return f
+}
+
+// StackTrace returns the full stack captured when the annotation was made,
+// or nil if stack capture was disabled at the time.
+func (e *ContextError) StackTrace() []runtime.Frame {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	var frames []runtime.Frame
+	iter := runtime.CallersFrames(e.stack)
+	for {
+		f, more := iter.Next()
+		frames = append(frames, f)
+		if !more {
+			return frames
+		}
+	}
+}
+
+// Format implements fmt.Formatter.
+//
+// %v and %s print the same message as Error, %q prints it quoted, and %+v prints the full annotation chain.
+func (e *ContextError) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('+'):
+		var cause error = e
+		for {
+			ce, ok := cause.(*ContextError)
+			if !ok {
+				break
+			}
+			fmt.Fprintln(s, ce.Frame())
+			cause = ce.err
+		}
+		fmt.Fprintf(s, "caused by: %+v", cause)
+	case verb == 'q':
+		fmt.Fprintf(s, "%q", e.Error())
+	default:
+		fmt.Fprint(s, e.Error())
+	}
+}
+
+// Context annotates the error inside the Result with a message, if it is Err.
+//
+// Type signature:
+//
+//	Context :: Result a -> String -> Result a
+//
+// The error is wrapped in a *ContextError. If the Result is Ok, it is returned unchanged.
+func (r Result[T]) Context(msg string) Result[T] {
+	if !r.isErr {
+		return r
+	}
+	return Err[T](wrapContext(r.err, msg))
+}
+
+// Wrapf annotates the error inside the Result with a formatted message, if it is Err.
+//
+// Type signature:
+//
+//	Wrapf :: Result a -> String -> [any] -> Result a
+//
+// The message is formatted with fmt.Sprintf. If the Result is Ok, it is returned unchanged
+// and the message is never formatted.
+func (r Result[T]) Wrapf(format string, args ...any) Result[T] {
+	if !r.isErr {
+		return r
+	}
+	return Err[T](wrapContext(r.err, fmt.Sprintf(format, args...)))
+}
+
+// Trace lists the annotations made on the error inside the Result, outermost first.
+//
+// Type signature:
+//
+//	Trace :: Result a -> [Frame]
+//
+// The error tree is walked depth-first in the same order as errors.Is, so annotations on every branch
+// of an errors.Join or a multi-%w error are included. It returns nil if the Result is Ok or its error
+// carries no annotations.
+func (r Result[T]) Trace() []Frame {
+	if !r.isErr {
+		return nil
+	}
+	return traceFrames(nil, r.err)
+}
+
+func traceFrames(frames []Frame, err error) []Frame {
+	for err != nil {
+		if ce, ok := err.(*ContextError); ok {
+			frames = append(frames, ce.Frame())
+		}
+		if multi, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range multi.Unwrap() {
+				frames = traceFrames(frames, e)
+			}
+			return frames
+		}
+		err = errors.Unwrap(err)
+	}
+	return frames
+}
@@ -0,0 +1,117 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestContextOk(t *testing.T) {
+	res := result.Ok(42).Context("loading value")
+	if !res.IsOk() {
+		t.Errorf("expected Ok result")
+	}
+	if res.Trace() != nil {
+		t.Errorf("expected no trace for Ok result")
+	}
+}
+
+func TestContextErr(t *testing.T) {
+	res := result.Err[int](fs.ErrNotExist).
+		Context("opening config").
+		Wrapf("loading %s", "app.yaml")
+
+	res.Match(func(val int) {
+		t.Errorf("unexpected success value: %d", val)
+	}, func(err error) {
+		if err.Error() != "loading app.yaml: opening config: file does not exist" {
+			t.Errorf("unexpected error message: %v", err)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("expected error to wrap fs.ErrNotExist")
+		}
+		var ce *result.ContextError
+		if !errors.As(err, &ce) || ce.Frame().Message != "loading app.yaml" {
+			t.Errorf("expected outermost context error, got %v", err)
+		}
+	})
+
+	trace := res.Trace()
+	if len(trace) != 2 || trace[0].Message != "loading app.yaml" || trace[1].Message != "opening config" {
+		t.Errorf("unexpected trace: %v", trace)
+	}
+	if trace[0].File != "" {
+		t.Errorf("expected no location without stack capture")
+	}
+}
+
+func TestContextStackCapture(t *testing.T) {
+	result.SetStackCapture(true)
+	defer result.SetStackCapture(false)
+
+	res := result.Err[int](errors.New("test error")).Context("step")
+	trace := res.Trace()
+	if len(trace) != 1 {
+		t.Fatalf("expected single frame, got %v", trace)
+	}
+	if !strings.HasSuffix(trace[0].File, "result_context_test.go") ||
+		!strings.HasSuffix(trace[0].Function, "TestContextStackCapture") {
+		t.Errorf("expected frame at annotation site, got %v", trace[0])
+	}
+
+	res.Match(func(int) {}, func(err error) {
+		var ce *result.ContextError
+		if errors.As(err, &ce) && len(ce.StackTrace()) == 0 {
+			t.Errorf("expected captured stack trace")
+		}
+	})
+}
+
+func TestContextFormat(t *testing.T) {
+	res := result.Err[int](errors.New("root")).Context("inner").Context("outer")
+	res.Match(func(int) {}, func(err error) {
+		if got := fmt.Sprintf("%v", err); got != "outer: inner: root" {
+			t.Errorf("unexpected %%v output: %q", got)
+		}
+		if got := fmt.Sprintf("%+v", err); got != "outer\ninner\ncaused by: root" {
+			t.Errorf("unexpected %%+v output: %q", got)
+		}
+	})
+}
+
+func TestContextNilError(t *testing.T) {
+	res := result.Err[int](nil).Context("outer")
+	res.Match(func(int) {}, func(err error) {
+		if got := err.Error(); got != "outer" {
+			t.Errorf("unexpected error message: %q", got)
+		}
+	})
+}
+
+func TestContextTraceMultiple(t *testing.T) {
+	annotated := func(msg string) error {
+		_, err := result.Err[int](errors.New("root")).Context(msg).Unpack()
+		return err
+	}
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{"join", errors.Join(annotated("first"), annotated("second"))},
+		{"wrap", fmt.Errorf("both: %w, %w", annotated("first"), annotated("second"))},
+	}
+	for _, tt := range tests {
+		trace := result.Err[int](tt.err).Context("outer").Trace()
+		var got []string
+		for _, f := range trace {
+			got = append(got, f.Message)
+		}
+		if strings.Join(got, ",") != "outer,first,second" {
+			t.Errorf("%s: unexpected trace: %v", tt.name, got)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/option"
+)
+
+// Unpack returns the value and error inside the Result as an idiomatic Go pair.
+//
+// Type signature:
+//
+//	Unpack :: Result a -> (a, error)
+//
+// If the Result is Ok, it returns the value and a nil error. If it's Err, it returns the zero value and the error.
+func (r Result[T]) Unpack() (T, error) {
+	if r.isErr {
+		var zero T
+		return zero, r.err
+	}
+	return r.value, nil
+}
+
+// Must returns the value inside the Result, panicking if it is Err.
+//
+// Type signature:
+//
+//	Must :: Result a -> a
+//
+// The panic value is the error held by the Result.
+func (r Result[T]) Must() T {
+	if r.isErr {
+		panic(r.err)
+	}
+	return r.value
+}
+
+// Expect returns the value inside the Result, panicking with the provided message if it is Err.
+//
+// Type signature:
+//
+//	Expect :: Result a -> String -> a
+//
+// The panic value is an error with the message prefixed to, and wrapping, the error held by the Result.
+func (r Result[T]) Expect(msg string) T {
+	if r.isErr {
+		panic(fmt.Errorf("%s: %w", msg, r.err))
+	}
+	return r.value
+}
+
+// ToOption converts the Result into an Option, discarding the error.
+//
+// Type signature:
+//
+//	ToOption :: Result a -> Option a
+//
+// If the Result is Ok, it returns Some with the value. If it's Err, it returns None.
+func (r Result[T]) ToOption() option.Option[T] {
+	if r.isErr {
+		return option.None[T]()
+	}
+	return option.Some(r.value)
+}
+
+// ToEither converts the Result into an Either, with the error on the Left and the value on the Right.
+//
+// Type signature:
+//
+//	ToEither :: Result a -> Either error a
+func (r Result[T]) ToEither() either.Either[error, T] {
+	if r.isErr {
+		return either.Left[T](r.err)
+	}
+	return either.Right[error](r.value)
+}
+
+// FromOption converts an Option into a Result, using the provided error if the Option is None.
+//
+// Type signature:
+//
+//	FromOption :: Option a -> error -> Result a
+//
+// If the Option is Some, it returns Ok with the value. If it's None, it returns Err with errIfNone.
+func FromOption[T any](opt option.Option[T], errIfNone error) Result[T] {
+	return option.BiMap(opt, Ok[T], func() Result[T] { return Err[T](errIfNone) })
+}
+
+// FromEither converts an Either with an error on the Left into a Result.
+//
+// Type signature:
+//
+//	FromEither :: Either error a -> Result a
+//
+// If the Either is Right, it returns Ok with the right value. If it's Left, it returns Err with the left error.
+func FromEither[T any](e either.Either[error, T]) Result[T] {
+	return either.BiMap(e, Err[T], Ok[T])
+}
@@ -0,0 +1,97 @@
+package result_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/either"
+	"github.com/alsi-lawr/gonads/option"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestUnpackOk(t *testing.T) {
+	val, err := result.Ok(42).Unpack()
+	if val != 42 || err != nil {
+		t.Errorf("expected (42, nil), got (%d, %v)", val, err)
+	}
+}
+
+func TestUnpackErr(t *testing.T) {
+	testErr := errors.New("test error")
+	val, err := result.Err[int](testErr).Unpack()
+	if val != 0 || err != testErr {
+		t.Errorf("expected (0, %v), got (%d, %v)", testErr, val, err)
+	}
+}
+
+func TestMustOk(t *testing.T) {
+	if val := result.Ok(42).Must(); val != 42 {
+		t.Errorf("expected value to be 42, got %d", val)
+	}
+}
+
+func TestMustErr(t *testing.T) {
+	testErr := errors.New("test error")
+	defer func() {
+		if r := recover(); r != testErr {
+			t.Errorf("expected panic with %v, got %v", testErr, r)
+		}
+	}()
+	result.Err[int](testErr).Must()
+}
+
+func TestExpectErr(t *testing.T) {
+	testErr := errors.New("test error")
+	defer func() {
+		err, ok := recover().(error)
+		if !ok || !errors.Is(err, testErr) || err.Error() != "loading config: test error" {
+			t.Errorf("expected panic wrapping %v, got %v", testErr, err)
+		}
+	}()
+	result.Err[int](testErr).Expect("loading config")
+}
+
+func TestExpectOk(t *testing.T) {
+	if val := result.Ok(42).Expect("unreachable"); val != 42 {
+		t.Errorf("expected value to be 42, got %d", val)
+	}
+}
+
+func TestToOption(t *testing.T) {
+	if !option.Equal(result.Ok(42).ToOption(), option.Some(42)) {
+		t.Errorf("expected Ok to convert to Some")
+	}
+	if result.Err[int](errors.New("test error")).ToOption().IsSome() {
+		t.Errorf("expected Err to convert to None")
+	}
+}
+
+func TestToEither(t *testing.T) {
+	testErr := errors.New("test error")
+	if e := result.Ok(42).ToEither(); !e.IsRight() || *e.RightOrNil() != 42 {
+		t.Errorf("expected Ok to convert to Right")
+	}
+	if e := result.Err[int](testErr).ToEither(); !e.IsLeft() || *e.LeftOrNil() != testErr {
+		t.Errorf("expected Err to convert to Left")
+	}
+}
+
+func TestFromOption(t *testing.T) {
+	testErr := errors.New("none")
+	if !result.Equal(result.FromOption(option.Some(42), testErr), result.Ok(42)) {
+		t.Errorf("expected Some to convert to Ok")
+	}
+	if !result.Equal(result.FromOption(option.None[int](), testErr), result.Err[int](testErr)) {
+		t.Errorf("expected None to convert to Err")
+	}
+}
+
+func TestFromEither(t *testing.T) {
+	testErr := errors.New("test error")
+	if !result.Equal(result.FromEither(either.Right[error](42)), result.Ok(42)) {
+		t.Errorf("expected Right to convert to Ok")
+	}
+	if !result.Equal(result.FromEither(either.Left[int](testErr)), result.Err[int](testErr)) {
+		t.Errorf("expected Left to convert to Err")
+	}
+}
@@ -0,0 +1,93 @@
+package result
+
+// Scope is the handle passed to a Do block, used to unwrap Results inside it.
+//
+// A Scope is only valid on the goroutine running its Do block and only until the block returns.
+type Scope struct {
+	done bool
+}
+
+// exit is the value Get panics with to leave a Do block early. It records the Scope it belongs to,
+// so that nested Do blocks only recover their own exits.
+type exit struct {
+	scope *Scope
+	err   error
+}
+
+// Do runs a block of imperative-looking code with monadic semantics.
+//
+// Type signature:
+//
+//	Do :: (Scope -> a) -> Result a
+//
+// Inside the block, Get unwraps Results. The first Err passed to Get stops the block, and Do returns that Err;
+// otherwise Do returns Ok with the block's value:
+//
+//	sum := result.Do(func(s *result.Scope) int {
+//		a := result.Get(s, parse("1"))
+//		b := result.Get(s, parse("2"))
+//		return a + b
+//	})
+//
+// Early exits are implemented with a panic that Do always recovers. Any other panic raised by the block is propagated.
+func Do[T any](fn func(s *Scope) T) (res Result[T]) {
+	s := &Scope{}
+	defer func() {
+		s.done = true
+		if r := recover(); r != nil {
+			if e, ok := r.(exit); ok && e.scope == s {
+				res = Err[T](e.err)
+				return
+			}
+			panic(r)
+		}
+	}()
+	return Ok(fn(s))
+}
+
+// Get unwraps a Result inside a Do block.
+//
+// Type signature:
+//
+//	Get :: Scope -> Result a -> a
+//
+// It returns the value of an Ok. If the Result is Err, the enclosing Do block stops and returns the error.
+// Get is a function rather than a method of Scope because Go methods cannot have type parameters.
+//
+// Get panics if the Scope is used after its Do block has returned.
+func Get[T any](s *Scope, r Result[T]) T {
+	if r.isErr {
+		s.Fail(r.err)
+	}
+	return r.value
+}
+
+// Check stops the enclosing Do block with err if it is not nil.
+//
+// Type signature:
+//
+//	Check :: Scope -> error -> ()
+//
+// It is useful for functions that return (T, error):
+//
+//	n, err := strconv.Atoi(s)
+//	scope.Check(err)
+func (s *Scope) Check(err error) {
+	if err != nil {
+		s.Fail(err)
+	}
+}
+
+// Fail stops the enclosing Do block, which returns an Err holding err.
+//
+// Type signature:
+//
+//	Fail :: Scope -> error -> ()
+//
+// Fail panics if the Scope is used after its Do block has returned.
+func (s *Scope) Fail(err error) {
+	if s.done {
+		panic("result: Scope used after its Do block returned")
+	}
+	panic(exit{scope: s, err: err})
+}
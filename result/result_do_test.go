@@ -0,0 +1,99 @@
+package result_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func parse(s string) result.Result[int] {
+	return result.Lift(func() (int, error) { return strconv.Atoi(s) })
+}
+
+func TestDoOk(t *testing.T) {
+	res := result.Do(func(s *result.Scope) int {
+		a := result.Get(s, parse("1"))
+		b := result.Get(s, parse("2"))
+		c, err := strconv.Atoi("3")
+		s.Check(err)
+		return a + b + c
+	})
+	if val, err := res.Unpack(); err != nil || val != 6 {
+		t.Errorf("expected Ok(6), got %v", res)
+	}
+}
+
+func TestDoShortCircuits(t *testing.T) {
+	reached := false
+	res := result.Do(func(s *result.Scope) int {
+		a := result.Get(s, parse("x"))
+		reached = true
+		return a
+	})
+	if res.IsOk() {
+		t.Fatalf("expected Err, got %v", res)
+	}
+	if reached {
+		t.Errorf("expected the block to stop at the first Err")
+	}
+	var numErr *strconv.NumError
+	if _, err := res.Unpack(); !errors.As(err, &numErr) {
+		t.Errorf("expected the error from parse, got %v", err)
+	}
+}
+
+func TestDoCheckAndFail(t *testing.T) {
+	errTest := errors.New("test error")
+	res := result.Do(func(s *result.Scope) int {
+		s.Check(nil)
+		s.Fail(errTest)
+		return 1
+	})
+	if _, err := res.Unpack(); err != errTest {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+}
+
+func TestDoNested(t *testing.T) {
+	errInner := errors.New("inner")
+	res := result.Do(func(outer *result.Scope) int {
+		inner := result.Do(func(s *result.Scope) int {
+			return result.Get(s, result.Err[int](errInner))
+		})
+		if inner.IsOk() {
+			t.Errorf("expected the inner block to fail")
+		}
+		return result.Do(func(s *result.Scope) int {
+			outer.Fail(errors.New("outer"))
+			return 0
+		}).Must()
+	})
+	if _, err := res.Unpack(); err == nil || err.Error() != "outer" {
+		t.Errorf("expected the outer Scope's exit to reach the outer block, got %v", err)
+	}
+}
+
+func TestDoPropagatesOtherPanics(t *testing.T) {
+	defer func() {
+		if r := recover(); r != "boom" {
+			t.Errorf("expected panic \"boom\", got %v", r)
+		}
+	}()
+	result.Do(func(s *result.Scope) int { panic("boom") })
+}
+
+func TestDoScopeAfterReturn(t *testing.T) {
+	var leaked *result.Scope
+	result.Do(func(s *result.Scope) int {
+		leaked = s
+		return 0
+	})
+	defer func() {
+		if r := recover(); r != "result: Scope used after its Do block returned" {
+			t.Errorf("expected a misuse panic, got %v", r)
+		}
+	}()
+	leaked.Fail(errors.New("late"))
+}
@@ -0,0 +1,39 @@
+package result
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// String returns the Result rendered as Ok(value) or Err(message).
+//
+// Type signature:
+//
+//	String :: Result a -> String
+func (r Result[T]) String() string {
+	return fmt.Sprint(r)
+}
+
+// Format implements fmt.Formatter.
+//
+// The Result is rendered as Ok(value) or Err(message), with the verb and flags applied to the value or error.
+// %+v renders an Err with the full error chain, such as the annotations added by Context.
+// %#v renders a Go-syntax constructor expression, such as result.Ok[int](3).
+func (r Result[T]) Format(s fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && s.Flag('#') && r.isErr && r.err == nil:
+		fmt.Fprintf(s, "result.Err[%s](nil)", typeName[T]())
+	case verb == 'v' && s.Flag('#') && r.isErr:
+		fmt.Fprintf(s, "result.Err[%s](errors.New(%q))", typeName[T](), r.err.Error())
+	case verb == 'v' && s.Flag('#'):
+		fmt.Fprintf(s, "result.Ok[%s](%#v)", typeName[T](), r.value)
+	case r.isErr:
+		fmt.Fprintf(s, "Err("+fmt.FormatString(s, verb)+")", r.err)
+	default:
+		fmt.Fprintf(s, "Ok("+fmt.FormatString(s, verb)+")", r.value)
+	}
+}
+
+func typeName[T any]() string {
+	return reflect.TypeOf((*T)(nil)).Elem().String()
+}
@@ -0,0 +1,41 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestFormat(t *testing.T) {
+	chained := result.Err[int](errors.New("root")).Context("outer")
+	tests := []struct {
+		format string
+		res    any
+		want   string
+	}{
+		{"%v", result.Ok(3), "Ok(3)"},
+		{"%v", result.Err[int](errors.New("boom")), "Err(boom)"},
+		{"%x", result.Ok(255), "Ok(ff)"},
+		{"%v", chained, "Err(outer: root)"},
+		{"%+v", chained, "Err(outer\ncaused by: root)"},
+		{"%#v", result.Ok("x"), `result.Ok[string]("x")`},
+		{"%#v", result.Err[int](errors.New("boom")), `result.Err[int](errors.New("boom"))`},
+		{"%q", result.Err[int](errors.New("boom")), `Err("boom")`},
+		{"%x", result.Err[int](errors.New("hi")), "Err(6869)"},
+		{"%v", result.Err[int](nil), "Err(<nil>)"},
+		{"%#v", result.Err[int](nil), "result.Err[int](nil)"},
+	}
+	for _, tt := range tests {
+		if got := fmt.Sprintf(tt.format, tt.res); got != tt.want {
+			t.Errorf("Sprintf(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestString(t *testing.T) {
+	if got := result.Ok(42).String(); got != "Ok(42)" {
+		t.Errorf("String() = %q, want %q", got, "Ok(42)")
+	}
+}
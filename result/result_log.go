@@ -0,0 +1,21 @@
+package result
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// LogValue implements slog.LogValuer.
+//
+// Type signature:
+//
+//	LogValue :: Result a -> slog.Value
+//
+// Ok is logged as a group with an "ok" attribute holding the value, and Err is logged as a group
+// with an "err" attribute holding the error message, or "<nil>" for Err(nil).
+func (r Result[T]) LogValue() slog.Value {
+	if r.isErr {
+		return slog.GroupValue(slog.String("err", fmt.Sprint(r.err)))
+	}
+	return slog.GroupValue(slog.Any("ok", r.value))
+}
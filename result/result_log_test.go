@@ -0,0 +1,33 @@
+package result_test
+
+import (
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestLogValueOk(t *testing.T) {
+	v := result.Ok(42).LogValue()
+	attrs := v.Group()
+	if v.Kind() != slog.KindGroup || len(attrs) != 1 || attrs[0].Key != "ok" || attrs[0].Value.Int64() != 42 {
+		t.Errorf("unexpected log value: %v", v)
+	}
+}
+
+func TestLogValueErr(t *testing.T) {
+	v := result.Err[int](errors.New("test error")).LogValue()
+	attrs := v.Group()
+	if v.Kind() != slog.KindGroup || len(attrs) != 1 || attrs[0].Key != "err" || attrs[0].Value.String() != "test error" {
+		t.Errorf("unexpected log value: %v", v)
+	}
+}
+
+func TestLogValueNilErr(t *testing.T) {
+	v := result.Err[int](nil).LogValue()
+	attrs := v.Group()
+	if len(attrs) != 1 || attrs[0].Key != "err" || attrs[0].Value.String() != "<nil>" {
+		t.Errorf("unexpected log value: %v", v)
+	}
+}
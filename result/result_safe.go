@@ -0,0 +1,42 @@
+package result
+
+import "github.com/alsi-lawr/gonads/internal/panics"
+
+// PanicError is the error held by an Err produced from a recovered panic.
+// It carries the value passed to panic and the stack of the goroutine that panicked,
+// and can be detected with errors.As.
+//
+// If the recovered value is itself an error, PanicError unwraps to it.
+type PanicError = panics.Error
+
+// LiftSafe lifts a function that returns a value and an error into a Result, recovering from panics.
+//
+// Type signature:
+//
+//	LiftSafe :: (() -> (a, error)) -> Result a
+//
+// It behaves like Lift, but if fn panics the panic is recovered and returned as an Err holding a *PanicError.
+func LiftSafe[T any](fn func() (T, error)) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = Err[T](panics.New(r))
+		}
+	}()
+	return Lift(fn)
+}
+
+// TryCatch runs a function that may panic, capturing its value in a Result.
+//
+// Type signature:
+//
+//	TryCatch :: (() -> a) -> Result a
+//
+// It returns Ok with the value returned by fn, or an Err holding a *PanicError if fn panics.
+func TryCatch[T any](fn func() T) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = Err[T](panics.New(r))
+		}
+	}()
+	return Ok(fn())
+}
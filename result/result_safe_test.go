@@ -0,0 +1,82 @@
+package result_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/result"
+)
+
+func TestLiftSafeOk(t *testing.T) {
+	res := result.LiftSafe(func() (int, error) {
+		return 42, nil
+	})
+	res.Match(func(val int) {
+		if val != 42 {
+			t.Errorf("expected value to be 42, got %d", val)
+		}
+	}, func(err error) {
+		t.Errorf("unexpected error: %v", err)
+	})
+}
+
+func TestLiftSafeErr(t *testing.T) {
+	testErr := errors.New("test error")
+	res := result.LiftSafe(func() (int, error) {
+		return 0, testErr
+	})
+	res.Match(func(val int) {
+		t.Errorf("unexpected success value: %d", val)
+	}, func(err error) {
+		var panicErr *result.PanicError
+		if errors.As(err, &panicErr) {
+			t.Errorf("expected plain error, got panic error")
+		}
+		if err != testErr {
+			t.Errorf("expected error to be %v, got %v", testErr, err)
+		}
+	})
+}
+
+func TestLiftSafePanic(t *testing.T) {
+	res := result.LiftSafe(func() (int, error) {
+		panic("boom")
+	})
+	res.Match(func(val int) {
+		t.Errorf("unexpected success value: %d", val)
+	}, func(err error) {
+		var panicErr *result.PanicError
+		if !errors.As(err, &panicErr) {
+			t.Fatalf("expected panic error, got %v", err)
+		}
+		if panicErr.Value != "boom" {
+			t.Errorf("expected panic value to be 'boom', got %v", panicErr.Value)
+		}
+		if !strings.Contains(string(panicErr.Stack), "TestLiftSafePanic") {
+			t.Errorf("expected stack to contain the panicking test")
+		}
+	})
+}
+
+func TestTryCatchOk(t *testing.T) {
+	res := result.TryCatch(func() int { return 42 })
+	if !res.IsOk() {
+		t.Errorf("expected Ok result")
+	}
+}
+
+func TestTryCatchPanicError(t *testing.T) {
+	cause := errors.New("cause")
+	res := result.TryCatch(func() int { panic(cause) })
+	res.Match(func(val int) {
+		t.Errorf("unexpected success value: %d", val)
+	}, func(err error) {
+		if !errors.Is(err, cause) {
+			t.Errorf("expected error to unwrap to the panic value, got %v", err)
+		}
+		if err.Error() != "recovered panic: cause" {
+			t.Errorf("unexpected error message: %v", err)
+		}
+	})
+}
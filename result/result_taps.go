@@ -0,0 +1,41 @@
+package result
+
+// Tap runs a side effect on the value inside the Result, if it is Ok.
+//
+// Type signature:
+//
+//	Tap :: Result a -> (a -> ()) -> Result a
+//
+// It returns the Result unchanged, allowing the side effect to be inserted into a chain.
+func (r Result[T]) Tap(fn func(T)) Result[T] {
+	if !r.isErr {
+		fn(r.value)
+	}
+	return r
+}
+
+// TapErr runs a side effect on the error inside the Result, if it is Err.
+//
+// Type signature:
+//
+//	TapErr :: Result a -> (error -> ()) -> Result a
+//
+// It returns the Result unchanged, allowing the side effect to be inserted into a chain.
+func (r Result[T]) TapErr(fn func(error)) Result[T] {
+	if r.isErr {
+		fn(r.err)
+	}
+	return r
+}
+
+// Inspect runs a side effect on the Result itself, whether it is Ok or Err.
+//
+// Type signature:
+//
+//	Inspect :: Result a -> (Result a -> ()) -> Result a
+//
+// It returns the Result unchanged, allowing the side effect to be inserted into a chain.
+func (r Result[T]) Inspect(fn func(Result[T])) Result[T] {
+	fn(r)
+	return r
+}
@@ -253,3 +253,41 @@ func TestLiftFuncErr(t *testing.T) {
 		}
 	})
 }
+
+func TestTapOk(t *testing.T) {
+	var seen int
+	res := result.Ok(42).Tap(func(val int) { seen = val })
+	if seen != 42 || !res.IsOk() {
+		t.Errorf("expected Tap to see 42 and return the Result unchanged")
+	}
+	result.Ok(42).TapErr(func(error) { t.Errorf("expected TapErr not to run on Ok") })
+}
+
+func TestTapErr(t *testing.T) {
+	var seen error
+	testErr := errors.New("test error")
+	res := result.Err[int](testErr).TapErr(func(err error) { seen = err })
+	if seen != testErr || !res.IsErr() {
+		t.Errorf("expected TapErr to see the error and return the Result unchanged")
+	}
+	result.Err[int](testErr).Tap(func(int) { t.Errorf("expected Tap not to run on Err") })
+}
+
+func TestInspect(t *testing.T) {
+	calls := 0
+	result.Ok(42).Inspect(func(r result.Result[int]) {
+		calls++
+		if !r.IsOk() {
+			t.Errorf("expected Inspect to see Ok result")
+		}
+	})
+	result.Err[int](errors.New("test error")).Inspect(func(r result.Result[int]) {
+		calls++
+		if !r.IsErr() {
+			t.Errorf("expected Inspect to see Err result")
+		}
+	})
+	if calls != 2 {
+		t.Errorf("expected Inspect to run twice, ran %d times", calls)
+	}
+}
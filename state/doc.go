@@ -0,0 +1,24 @@
+/*
+Package state provides an implementation of the State monad, used to thread a value through a sequence of
+computations that each read and update it, without mutable shared variables.
+
+A State is a function from an initial state to a value and a new state. States are composed with Map and Bind,
+and nothing runs until the composed State is given an initial state with Run.
+
+State consists of:
+
+	Of/Get/Gets/Put/Modify: Create States that return a value, read the state or update it.
+	Map/Bind: Compose States, threading the state from one to the next.
+	Run/Eval/Exec: Run a State, returning the value and final state, the value alone or the final state alone.
+
+Usage Example:
+
+	next := state.Bind(state.Get[int](), func(n int) state.State[int, int] {
+	    return state.Map(state.Put(n+1), func(struct{}) int { return n })
+	})
+
+	id, counter := state.Bind(next, func(int) state.State[int, int] { return next }).Run(0)
+
+In this example, next returns the current counter and increments it, so id is 1 and counter is 2.
+*/
+package state
@@ -0,0 +1,124 @@
+package state
+
+// State represents a computation that reads and updates a state while producing a value.
+//
+// Type signature:
+//
+//	State[S, T] :: s -> (a, s)
+type State[S, T any] func(s S) (T, S)
+
+// Of creates a State that returns val and leaves the state unchanged.
+//
+// Type signature:
+//
+//	Of :: a -> State s a
+func Of[S, T any](val T) State[S, T] {
+	return func(s S) (T, S) { return val, s }
+}
+
+// Get creates a State that returns the current state.
+//
+// Type signature:
+//
+//	Get :: State s s
+func Get[S any]() State[S, S] {
+	return func(s S) (S, S) { return s, s }
+}
+
+// Gets creates a State that returns a value derived from the current state.
+//
+// Type signature:
+//
+//	Gets :: (s -> a) -> State s a
+func Gets[S, T any](fn func(S) T) State[S, T] {
+	return func(s S) (T, S) { return fn(s), s }
+}
+
+// Put creates a State that replaces the current state with s.
+//
+// Type signature:
+//
+//	Put :: s -> State s ()
+func Put[S any](s S) State[S, struct{}] {
+	return func(S) (struct{}, S) { return struct{}{}, s }
+}
+
+// Modify creates a State that updates the current state with fn.
+//
+// Type signature:
+//
+//	Modify :: (s -> s) -> State s ()
+func Modify[S any](fn func(S) S) State[S, struct{}] {
+	return func(s S) (struct{}, S) { return struct{}{}, fn(s) }
+}
+
+// Run runs the State from an initial state, returning its value and the final state.
+//
+// Type signature:
+//
+//	Run :: State s a -> s -> (a, s)
+func (st State[S, T]) Run(initial S) (T, S) {
+	return st(initial)
+}
+
+// Eval runs the State from an initial state, returning only its value.
+//
+// Type signature:
+//
+//	Eval :: State s a -> s -> a
+func (st State[S, T]) Eval(initial S) T {
+	val, _ := st(initial)
+	return val
+}
+
+// Exec runs the State from an initial state, returning only the final state.
+//
+// Type signature:
+//
+//	Exec :: State s a -> s -> s
+func (st State[S, T]) Exec(initial S) S {
+	_, s := st(initial)
+	return s
+}
+
+// Map applies a function to the value of the State.
+//
+// Type signature:
+//
+//	Map :: State s a -> (a -> a) -> State s a
+func (st State[S, T]) Map(fn func(T) T) State[S, T] {
+	return Map(st, fn)
+}
+
+// Map applies a function to the value of the State.
+//
+// Type signature:
+//
+//	Map :: State s a -> (a -> b) -> State s b
+func Map[S, T, U any](st State[S, T], fn func(T) U) State[S, U] {
+	return func(s S) (U, S) {
+		val, next := st(s)
+		return fn(val), next
+	}
+}
+
+// Bind applies a function returning a State to the value of the State, threading the state through both.
+//
+// Type signature:
+//
+//	Bind :: State s a -> (a -> State s a) -> State s a
+func (st State[S, T]) Bind(fn func(T) State[S, T]) State[S, T] {
+	return Bind(st, fn)
+}
+
+// Bind applies a function returning a State to the value of the State, threading the state through both.
+//
+// Type signature:
+//
+//	Bind :: State s a -> (a -> State s b) -> State s b
+func Bind[S, T, U any](st State[S, T], fn func(T) State[S, U]) State[S, U] {
+	return func(s S) (U, S) {
+		val, next := st(s)
+		return fn(val)(next)
+	}
+}
@@ -0,0 +1,49 @@
+package state_test
+
+import (
+	"testing"
+
+	"github.com/alsi-lawr/gonads/state"
+)
+
+var next = state.Bind(state.Get[int](), func(n int) state.State[int, int] {
+	return state.Map(state.Put(n+1), func(struct{}) int { return n })
+})
+
+func TestStateCounter(t *testing.T) {
+	id, counter := state.Bind(next, func(int) state.State[int, int] { return next }).Run(0)
+	if id != 1 || counter != 2 {
+		t.Errorf("expected (1, 2), got (%d, %d)", id, counter)
+	}
+}
+
+func TestStateRunners(t *testing.T) {
+	if got := next.Eval(5); got != 5 {
+		t.Errorf("expected 5, got %d", got)
+	}
+	if got := next.Exec(5); got != 6 {
+		t.Errorf("expected 6, got %d", got)
+	}
+}
+
+func TestStateConstructors(t *testing.T) {
+	if val, s := state.Of[string](1).Run("s"); val != 1 || s != "s" {
+		t.Errorf("expected (1, s), got (%d, %s)", val, s)
+	}
+	if val, _ := state.Gets(func(s string) int { return len(s) }).Run("abc"); val != 3 {
+		t.Errorf("expected 3, got %d", val)
+	}
+	double := state.Modify(func(s int) int { return s * 2 })
+	if got := double.Exec(4); got != 8 {
+		t.Errorf("expected 8, got %d", got)
+	}
+}
+
+func TestStateMethods(t *testing.T) {
+	st := next.Map(func(n int) int { return n * 10 }).Bind(func(n int) state.State[int, int] {
+		return state.Gets(func(s int) int { return n + s })
+	})
+	if val, s := st.Run(1); val != 12 || s != 2 {
+		t.Errorf("expected (12, 2), got (%d, %d)", val, s)
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Package task provides Task, a deferred, context-aware effect that produces a Result when run.
+
+A Task is a description of a computation rather than the computation itself: building, mapping and chaining
+Tasks performs no work, and side effects happen only when Run is called. This makes the order of effects
+explicit, and lets retries, timeouts and resource cleanup be attached as ordinary values.
+
+Task consists of:
+
+	Of/Fail/Lift/FromResult: Create Tasks from values, errors and context-aware functions.
+	Map/Bind/OnError: Transform, chain and recover Tasks.
+	Retry/Timeout: Re-run a failed Task, or bound how long it may take.
+	Bracket: Acquire a resource, use it and always release it.
+	Parallel: Run Tasks concurrently, collecting their values.
+
+Usage Example:
+
+	fetch := func(url string) task.Task[[]byte] {
+	    return task.Lift(func(ctx context.Context) ([]byte, error) {
+	        req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	        if err != nil {
+	            return nil, err
+	        }
+	        resp, err := http.DefaultClient.Do(req)
+	        if err != nil {
+	            return nil, err
+	        }
+	        defer resp.Body.Close()
+	        return io.ReadAll(resp.Body)
+	    })
+	}
+
+	pages := task.Parallel(fetch(a), fetch(b)).Retry(3, time.Second).Timeout(10 * time.Second)
+	bodies, err := pages.Run(ctx).Unpack()
+
+In this example, nothing is fetched until Run; both pages are then fetched concurrently, retried together on failure,
+and abandoned if they take longer than ten seconds in total.
+*/
+package task
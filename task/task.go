@@ -0,0 +1,253 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alsi-lawr/gonads/internal/panics"
+	"github.com/alsi-lawr/gonads/result"
+)
+
+// Task represents a deferred computation that uses a context and produces a Result.
+//
+// Type signature:
+//
+//	Task[T] :: Context -> Result a
+//
+// A Task does nothing until it is run. Run it with Run rather than calling it directly, so that
+// cancellation is respected and panics are recovered.
+type Task[T any] func(ctx context.Context) result.Result[T]
+
+// Of creates a Task that succeeds with val.
+//
+// Type signature:
+//
+//	Of :: a -> Task a
+func Of[T any](val T) Task[T] {
+	return FromResult(result.Ok(val))
+}
+
+// Fail creates a Task that fails with err.
+//
+// Type signature:
+//
+//	Fail :: error -> Task a
+func Fail[T any](err error) Task[T] {
+	return FromResult(result.Err[T](err))
+}
+
+// FromResult creates a Task that produces r.
+//
+// Type signature:
+//
+//	FromResult :: Result a -> Task a
+func FromResult[T any](r result.Result[T]) Task[T] {
+	return func(context.Context) result.Result[T] { return r }
+}
+
+// Lift lifts a context-aware function that returns a value and an error into a Task.
+//
+// Type signature:
+//
+//	Lift :: (Context -> (a, error)) -> Task a
+func Lift[T any](fn func(ctx context.Context) (T, error)) Task[T] {
+	return func(ctx context.Context) result.Result[T] {
+		return result.Lift(func() (T, error) { return fn(ctx) })
+	}
+}
+
+// Run runs the Task with ctx.
+//
+// Type signature:
+//
+//	Run :: Task a -> Context -> Result a
+//
+// If ctx is already done, the Task is not started and the context's error is returned.
+// If the Task panics, the panic is recovered and returned as an Err holding a *result.PanicError.
+func (t Task[T]) Run(ctx context.Context) (res result.Result[T]) {
+	if err := ctx.Err(); err != nil {
+		return result.Err[T](err)
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			res = result.Err[T](panics.New(r))
+		}
+	}()
+	return t(ctx)
+}
+
+// Map applies a function to the value of the Task, if it succeeds.
+//
+// Type signature:
+//
+//	Map :: Task a -> (a -> a) -> Task a
+func (t Task[T]) Map(fn func(T) T) Task[T] {
+	return Map(t, fn)
+}
+
+// Map applies a function to the value of the Task, if it succeeds.
+//
+// Type signature:
+//
+//	Map :: Task a -> (a -> b) -> Task b
+func Map[T, U any](t Task[T], fn func(T) U) Task[U] {
+	return func(ctx context.Context) result.Result[U] { return result.Map(t.Run(ctx), fn) }
+}
+
+// Bind runs the Task returned by fn after the Task succeeds.
+//
+// Type signature:
+//
+//	Bind :: Task a -> (a -> Task a) -> Task a
+func (t Task[T]) Bind(fn func(T) Task[T]) Task[T] {
+	return Bind(t, fn)
+}
+
+// Bind runs the Task returned by fn after the Task succeeds.
+//
+// Type signature:
+//
+//	Bind :: Task a -> (a -> Task b) -> Task b
+//
+// If the Task fails, fn is not called and the error is propagated.
+func Bind[T, U any](t Task[T], fn func(T) Task[U]) Task[U] {
+	return func(ctx context.Context) result.Result[U] {
+		return result.Bind(t.Run(ctx), func(val T) result.Result[U] { return fn(val).Run(ctx) })
+	}
+}
+
+// OnError runs the Task returned by fn if the Task fails, recovering from the error.
+//
+// Type signature:
+//
+//	OnError :: Task a -> (error -> Task a) -> Task a
+func (t Task[T]) OnError(fn func(error) Task[T]) Task[T] {
+	return func(ctx context.Context) result.Result[T] {
+		res := t.Run(ctx)
+		if res.IsErr() {
+			_, err := res.Unpack()
+			return fn(err).Run(ctx)
+		}
+		return res
+	}
+}
+
+// Retry runs the Task up to attempts times, until it succeeds, waiting backoff between attempts.
+//
+// Type signature:
+//
+//	Retry :: Task a -> Int -> Duration -> Task a
+//
+// If every attempt fails, the last error is returned. Retrying stops early, returning the context's error,
+// if the context is done while waiting.
+func (t Task[T]) Retry(attempts int, backoff time.Duration) Task[T] {
+	return func(ctx context.Context) result.Result[T] {
+		res := t.Run(ctx)
+		for i := 1; i < attempts && res.IsErr(); i++ {
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return result.Err[T](ctx.Err())
+			case <-timer.C:
+			}
+			res = t.Run(ctx)
+		}
+		return res
+	}
+}
+
+// Timeout bounds how long the Task may run.
+//
+// Type signature:
+//
+//	Timeout :: Task a -> Duration -> Task a
+//
+// The Task is run with a context that is cancelled after d. If it has not finished by then, Timeout returns
+// context.DeadlineExceeded without waiting for it, so a Task that ignores its context cannot block the caller.
+func (t Task[T]) Timeout(d time.Duration) Task[T] {
+	return func(ctx context.Context) result.Result[T] {
+		ctx, cancel := context.WithTimeout(ctx, d)
+		defer cancel()
+
+		done := make(chan result.Result[T], 1)
+		go func() { done <- t.Run(ctx) }()
+		select {
+		case res := <-done:
+			return res
+		case <-ctx.Done():
+			return result.Err[T](ctx.Err())
+		}
+	}
+}
+
+// Bracket acquires a resource, uses it and releases it, guaranteeing release once acquisition succeeds.
+//
+// Type signature:
+//
+//	Bracket :: Task r -> (r -> Task a) -> (r -> error) -> Task a
+//
+// If acquire fails, use and release are not called. Otherwise release is called after use, even if use fails or panics.
+// Errors from use and release are combined with errors.Join.
+func Bracket[R, T any](acquire Task[R], use func(R) Task[T], release func(R) error) Task[T] {
+	return func(ctx context.Context) result.Result[T] {
+		return result.Bind(acquire.Run(ctx), func(r R) result.Result[T] {
+			// use is called inside the Task, so a panic while building its Task is recovered too.
+			used := Task[T](func(ctx context.Context) result.Result[T] { return use(r)(ctx) })
+			res := used.Run(ctx)
+			releaseErr := release(r)
+			if releaseErr == nil {
+				return res
+			}
+			_, useErr := res.Unpack()
+			return result.Err[T](errors.Join(useErr, releaseErr))
+		})
+	}
+}
+
+// Parallel runs Tasks concurrently, collecting their values in order.
+//
+// Type signature:
+//
+//	Parallel :: [Task a] -> Task [a]
+//
+// If any Task fails, the others are cancelled through their context and the first error is returned.
+func Parallel[T any](tasks ...Task[T]) Task[[]T] {
+	return func(ctx context.Context) result.Result[[]T] {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		results := make([]result.Result[T], len(tasks))
+		var (
+			wg     sync.WaitGroup
+			once   sync.Once
+			failed = -1
+		)
+		for i, t := range tasks {
+			wg.Add(1)
+			go func(i int, t Task[T]) {
+				defer wg.Done()
+				results[i] = t.Run(ctx)
+				if results[i].IsErr() {
+					once.Do(func() {
+						failed = i
+						cancel()
+					})
+				}
+			}(i, t)
+		}
+		wg.Wait()
+
+		if failed >= 0 {
+			_, err := results[failed].Unpack()
+			return result.Err[[]T](err)
+		}
+		values := make([]T, len(results))
+		for i, r := range results {
+			values[i] = r.Must()
+		}
+		return result.Ok(values)
+	}
+}
@@ -0,0 +1,247 @@
+package task_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alsi-lawr/gonads/result"
+	"github.com/alsi-lawr/gonads/task"
+)
+
+var errTest = errors.New("test error")
+
+func TestTaskIsDeferred(t *testing.T) {
+	ran := false
+	tk := task.Lift(func(context.Context) (int, error) {
+		ran = true
+		return 1, nil
+	})
+	mapped := task.Map(tk, func(x int) int { return x + 1 })
+	if ran {
+		t.Fatalf("expected the Task not to run before Run")
+	}
+	if got := mapped.Run(context.Background()); !result.Equal(got, result.Ok(2)) {
+		t.Errorf("expected Ok(2), got %v", got)
+	}
+}
+
+func TestTaskConstructors(t *testing.T) {
+	ctx := context.Background()
+	if got := task.Of(1).Run(ctx); !result.Equal(got, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+	if _, err := task.Fail[int](errTest).Run(ctx).Unpack(); err != errTest {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+	if got := task.FromResult(result.Ok("x")).Run(ctx); !result.Equal(got, result.Ok("x")) {
+		t.Errorf("expected Ok(x), got %v", got)
+	}
+}
+
+func TestTaskRun(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	ran := false
+	tk := task.Lift(func(context.Context) (int, error) {
+		ran = true
+		return 1, nil
+	})
+	if _, err := tk.Run(ctx).Unpack(); !errors.Is(err, context.Canceled) || ran {
+		t.Errorf("expected a cancelled context to stop the Task before it starts, got %v", err)
+	}
+
+	panicky := task.Task[int](func(context.Context) result.Result[int] { panic("boom") })
+	var pe *result.PanicError
+	if _, err := panicky.Run(context.Background()).Unpack(); !errors.As(err, &pe) {
+		t.Errorf("expected a PanicError, got %v", err)
+	}
+}
+
+func TestTaskBind(t *testing.T) {
+	ctx := context.Background()
+	double := func(x int) task.Task[int] { return task.Of(x * 2) }
+	if got := task.Of(2).Bind(double).Map(func(x int) int { return x + 1 }).Run(ctx); !result.Equal(got, result.Ok(5)) {
+		t.Errorf("expected Ok(5), got %v", got)
+	}
+
+	called := false
+	chained := task.Bind(task.Fail[int](errTest), func(x int) task.Task[string] {
+		called = true
+		return task.Of("never")
+	})
+	if got := chained.Run(ctx); got.IsOk() || called {
+		t.Errorf("expected the error to short-circuit, got %v", got)
+	}
+}
+
+func TestTaskOnError(t *testing.T) {
+	recovered := task.Fail[int](errTest).OnError(func(err error) task.Task[int] {
+		if err != errTest {
+			t.Errorf("expected %v, got %v", errTest, err)
+		}
+		return task.Of(0)
+	})
+	if got := recovered.Run(context.Background()); !result.Equal(got, result.Ok(0)) {
+		t.Errorf("expected Ok(0), got %v", got)
+	}
+}
+
+func TestTaskRetry(t *testing.T) {
+	var calls atomic.Int32
+	flaky := task.Lift(func(context.Context) (int, error) {
+		if calls.Add(1) < 3 {
+			return 0, errTest
+		}
+		return 7, nil
+	})
+	if got := flaky.Retry(3, time.Millisecond).Run(context.Background()); !result.Equal(got, result.Ok(7)) {
+		t.Errorf("expected Ok(7), got %v", got)
+	}
+
+	calls.Store(0)
+	if got := flaky.Retry(2, time.Millisecond).Run(context.Background()); got.IsOk() || calls.Load() != 2 {
+		t.Errorf("expected 2 failed attempts, got %v after %d calls", got, calls.Load())
+	}
+}
+
+func TestTaskRetryCancelled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	got := task.Fail[int](errTest).Retry(100, time.Hour).Run(ctx)
+	if _, err := got.Unpack(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the context's error, got %v", err)
+	}
+}
+
+func TestTaskTimeout(t *testing.T) {
+	stuck := task.Lift(func(context.Context) (int, error) {
+		time.Sleep(time.Second)
+		return 1, nil
+	})
+	start := time.Now()
+	if _, err := stuck.Timeout(10 * time.Millisecond).Run(context.Background()).Unpack(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected Timeout not to wait for a Task that ignores its context")
+	}
+
+	if got := task.Of(1).Timeout(time.Second).Run(context.Background()); !result.Equal(got, result.Ok(1)) {
+		t.Errorf("expected Ok(1), got %v", got)
+	}
+}
+
+func TestBracket(t *testing.T) {
+	ctx := context.Background()
+	var released []string
+	release := func(name string) error {
+		released = append(released, name)
+		return nil
+	}
+
+	got := task.Bracket(task.Of("file"), func(name string) task.Task[int] { return task.Of(len(name)) }, release)
+	if res := got.Run(ctx); !result.Equal(res, result.Ok(4)) {
+		t.Errorf("expected Ok(4), got %v", res)
+	}
+
+	panicky := task.Bracket(task.Of("conn"), func(string) task.Task[int] {
+		return func(context.Context) result.Result[int] { panic("boom") }
+	}, release)
+	if res := panicky.Run(ctx); res.IsOk() {
+		t.Errorf("expected Err, got %v", res)
+	}
+
+	panickyUse := task.Bracket(task.Of("lock"), func(string) task.Task[int] { panic("boom") }, release)
+	if _, err := panickyUse.Run(ctx).Unpack(); !errors.As(err, new(*result.PanicError)) {
+		t.Errorf("expected a PanicError, got %v", err)
+	}
+
+	failedAcquire := task.Bracket(task.Fail[string](errTest), func(string) task.Task[int] { return task.Of(0) }, release)
+	if _, err := failedAcquire.Run(ctx).Unpack(); err != errTest {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+
+	if want := []string{"file", "conn", "lock"}; !reflect.DeepEqual(released, want) {
+		t.Errorf("expected releases %v, got %v", want, released)
+	}
+
+	errRelease := errors.New("release")
+	both := task.Bracket(task.Of("x"), func(string) task.Task[int] { return task.Fail[int](errTest) },
+		func(string) error { return errRelease })
+	if _, err := both.Run(ctx).Unpack(); !errors.Is(err, errTest) || !errors.Is(err, errRelease) {
+		t.Errorf("expected both errors, got %v", err)
+	}
+}
+
+func TestParallel(t *testing.T) {
+	sleepy := func(d time.Duration, val int) task.Task[int] {
+		return task.Lift(func(ctx context.Context) (int, error) {
+			select {
+			case <-time.After(d):
+				return val, nil
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		})
+	}
+
+	start := time.Now()
+	got := task.Parallel(sleepy(50*time.Millisecond, 1), sleepy(50*time.Millisecond, 2), sleepy(10*time.Millisecond, 3)).
+		Run(context.Background())
+	if vals, err := got.Unpack(); err != nil || !reflect.DeepEqual(vals, []int{1, 2, 3}) {
+		t.Errorf("expected Ok([1 2 3]), got %v", got)
+	}
+	if time.Since(start) > 140*time.Millisecond {
+		t.Errorf("expected the Tasks to run concurrently")
+	}
+
+	start = time.Now()
+	failed := task.Parallel(sleepy(time.Second, 1), task.Fail[int](errTest)).Run(context.Background())
+	if _, err := failed.Unpack(); err != errTest {
+		t.Errorf("expected %v, got %v", errTest, err)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected a failure to cancel the other Tasks")
+	}
+
+	if got := task.Parallel[int]().Run(context.Background()); !result.EqualFunc(got, result.Ok([]int{}), func(a, b []int) bool { return len(a) == len(b) }) {
+		t.Errorf("expected Ok([]), got %v", got)
+	}
+}
+
+func TestTaskNilErr(t *testing.T) {
+	ctx := context.Background()
+	nilErr := task.FromResult(result.Err[int](nil))
+
+	recovered := nilErr.OnError(func(error) task.Task[int] { return task.Of(1) })
+	if res := recovered.Run(ctx); !result.Equal(res, result.Ok(1)) {
+		t.Errorf("expected OnError to recover Err(nil), got %v", res)
+	}
+
+	bracket := task.Bracket(task.Of("x"), func(string) task.Task[int] { return nilErr },
+		func(string) error { return nil })
+	if res := bracket.Run(ctx); !res.IsErr() {
+		t.Errorf("expected Bracket to keep Err(nil), got %v", res)
+	}
+
+	start := time.Now()
+	slow := task.Lift(func(ctx context.Context) (int, error) {
+		select {
+		case <-time.After(time.Second):
+			return 1, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	})
+	parallel := task.Parallel(slow, nilErr).Run(ctx)
+	if _, err := parallel.Unpack(); !parallel.IsErr() || err != nil {
+		t.Errorf("expected Parallel to return Err(nil), got %v", parallel)
+	}
+	if time.Since(start) > 500*time.Millisecond {
+		t.Errorf("expected Err(nil) to cancel the other Tasks")
+	}
+}
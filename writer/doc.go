@@ -0,0 +1,28 @@
+/*
+Package writer provides an implementation of the Writer monad, used to accumulate a log, such as audit entries
+or metrics, alongside a computation.
+
+A Writer pairs a value with an output. When Writers are composed with Bind, their outputs are combined with a
+monoid, so the log is collected without being passed around or stored in shared state.
+
+Writer consists of:
+
+	Of/New/Tell: Create a Writer with a value, a value and output, or output alone.
+	Map/Bind: Compose Writers, combining their outputs.
+	Run: Extract the value and the accumulated output.
+
+Usage Example:
+
+	audit := monoid.Slice[string]()
+
+	func withdraw(balance, amount int) writer.Writer[[]string, int] {
+	    return writer.New(audit, balance-amount, []string{fmt.Sprintf("withdrew %d", amount)})
+	}
+
+	balance, log := writer.Bind(withdraw(100, 30), func(b int) writer.Writer[[]string, int] {
+	    return withdraw(b, 20)
+	}).Run()
+
+In this example, balance is 50 and log holds both audit entries, in order.
+*/
+package writer
@@ -0,0 +1,118 @@
+package writer
+
+import "github.com/alsi-lawr/gonads/monoid"
+
+// Writer represents a value paired with an accumulated output.
+//
+// Type signature:
+//
+//	Writer[W, T] :: (a, w)
+//
+// A Writer carries the monoid used to combine its output. Writers must be created with Of, New or Tell.
+type Writer[W, T any] struct {
+	value T
+	out   W
+	m     monoid.Monoid[W]
+}
+
+// Of creates a Writer holding val and an empty output.
+//
+// Type signature:
+//
+//	Of :: Monoid w -> a -> Writer w a
+func Of[W, T any](m monoid.Monoid[W], val T) Writer[W, T] {
+	return Writer[W, T]{value: val, out: m.Empty(), m: m}
+}
+
+// New creates a Writer holding val and out.
+//
+// Type signature:
+//
+//	New :: Monoid w -> a -> w -> Writer w a
+func New[W, T any](m monoid.Monoid[W], val T, out W) Writer[W, T] {
+	return Writer[W, T]{value: val, out: out, m: m}
+}
+
+// Tell creates a Writer holding only out.
+//
+// Type signature:
+//
+//	Tell :: Monoid w -> w -> Writer w ()
+func Tell[W any](m monoid.Monoid[W], out W) Writer[W, struct{}] {
+	return New(m, struct{}{}, out)
+}
+
+// Run returns the value and output of the Writer.
+//
+// Type signature:
+//
+//	Run :: Writer w a -> (a, w)
+func (w Writer[W, T]) Run() (T, W) {
+	return w.value, w.out
+}
+
+// Value returns the value of the Writer.
+//
+// Type signature:
+//
+//	Value :: Writer w a -> a
+func (w Writer[W, T]) Value() T {
+	return w.value
+}
+
+// Output returns the output of the Writer.
+//
+// Type signature:
+//
+//	Output :: Writer w a -> w
+func (w Writer[W, T]) Output() W {
+	return w.out
+}
+
+// Tell appends out to the output of the Writer.
+//
+// Type signature:
+//
+//	Tell :: Writer w a -> w -> Writer w a
+func (w Writer[W, T]) Tell(out W) Writer[W, T] {
+	return Writer[W, T]{value: w.value, out: w.m.Combine(w.out, out), m: w.m}
+}
+
+// Map applies a function to the value of the Writer, keeping its output.
+//
+// Type signature:
+//
+//	Map :: Writer w a -> (a -> a) -> Writer w a
+func (w Writer[W, T]) Map(fn func(T) T) Writer[W, T] {
+	return Map(w, fn)
+}
+
+// Map applies a function to the value of the Writer, keeping its output.
+//
+// Type signature:
+//
+//	Map :: Writer w a -> (a -> b) -> Writer w b
+func Map[W, T, U any](w Writer[W, T], fn func(T) U) Writer[W, U] {
+	return Writer[W, U]{value: fn(w.value), out: w.out, m: w.m}
+}
+
+// Bind applies a function returning a Writer to the value of the Writer, combining both outputs.
+//
+// Type signature:
+//
+//	Bind :: Writer w a -> (a -> Writer w a) -> Writer w a
+func (w Writer[W, T]) Bind(fn func(T) Writer[W, T]) Writer[W, T] {
+	return Bind(w, fn)
+}
+
+// Bind applies a function returning a Writer to the value of the Writer, combining both outputs.
+//
+// Type signature:
+//
+//	Bind :: Writer w a -> (a -> Writer w b) -> Writer w b
+//
+// The output of w comes before the output of the Writer returned by fn.
+func Bind[W, T, U any](w Writer[W, T], fn func(T) Writer[W, U]) Writer[W, U] {
+	next := fn(w.value)
+	return Writer[W, U]{value: next.value, out: w.m.Combine(w.out, next.out), m: w.m}
+}
@@ -0,0 +1,59 @@
+package writer_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/alsi-lawr/gonads/monoid"
+	"github.com/alsi-lawr/gonads/writer"
+)
+
+var audit = monoid.Slice[string]()
+
+func withdraw(balance, amount int) writer.Writer[[]string, int] {
+	return writer.New(audit, balance-amount, []string{fmt.Sprintf("withdrew %d", amount)})
+}
+
+func TestWriterBind(t *testing.T) {
+	balance, log := writer.Bind(withdraw(100, 30), func(b int) writer.Writer[[]string, int] {
+		return withdraw(b, 20)
+	}).Run()
+	if balance != 50 {
+		t.Errorf("expected 50, got %d", balance)
+	}
+	if want := []string{"withdrew 30", "withdrew 20"}; !reflect.DeepEqual(log, want) {
+		t.Errorf("expected %v, got %v", want, log)
+	}
+}
+
+func TestWriterOfAndTell(t *testing.T) {
+	w := writer.Of(monoid.Sum[int](), "value")
+	if w.Value() != "value" || w.Output() != 0 {
+		t.Errorf("expected (value, 0), got (%v, %v)", w.Value(), w.Output())
+	}
+	w = w.Tell(2).Tell(3)
+	if w.Output() != 5 {
+		t.Errorf("expected 5, got %d", w.Output())
+	}
+
+	tick := writer.Tell(monoid.Sum[int](), 1)
+	ticks := writer.Bind(tick, func(struct{}) writer.Writer[int, struct{}] { return tick })
+	if ticks.Output() != 2 {
+		t.Errorf("expected 2, got %d", ticks.Output())
+	}
+}
+
+func TestWriterMap(t *testing.T) {
+	w := withdraw(10, 1)
+	s := writer.Map(w, func(b int) string { return fmt.Sprint(b) })
+	if s.Value() != "9" || !reflect.DeepEqual(s.Output(), w.Output()) {
+		t.Errorf("expected Map to keep the output, got %v", s.Output())
+	}
+	doubled := w.Map(func(b int) int { return b * 2 }).Bind(func(b int) writer.Writer[[]string, int] {
+		return withdraw(b, 8)
+	})
+	if doubled.Value() != 10 || len(doubled.Output()) != 2 {
+		t.Errorf("expected (10, 2 entries), got (%d, %v)", doubled.Value(), doubled.Output())
+	}
+}